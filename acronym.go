@@ -0,0 +1,78 @@
+package slug
+
+import (
+	"strings"
+	"unicode"
+)
+
+func isLetterOrDigit(c rune) bool {
+	return unicode.IsLetter(c) || unicode.IsDigit(c)
+}
+
+// a letter that is a whole word by itself
+func isSingleLetter(runes []rune, i int) bool {
+	return i < len(runes) && unicode.IsLetter(runes[i]) &&
+		(i == 0 || !isLetterOrDigit(runes[i-1])) &&
+		(i+1 == len(runes) || !isLetterOrDigit(runes[i+1]))
+}
+
+/*
+	Removes the dots of dotted acronyms and initials so they become one word (Ex: "U.S.A. election" -> "USA election", "J.R.R. Tolkien" -> "JRR Tolkien").
+	At least two single letters are needed, each followed by a dot, the last dot is optional ("U.S.A"). Upper case initials may also
+	be separated by spaces ("George R. R. Martin" -> "George RR Martin"). Other dots are left alone, so sentences and version numbers
+	("Go 1.21") still split on them. A sentence that ends with a single upper case letter is joined with a following initial ("Plan B. J. Smith").
+*/
+func collapseAcronyms(title string) string {
+	if strings.IndexByte(title, '.') < 0 {
+		return title
+	}
+
+	runes := []rune(title)
+
+	var b strings.Builder
+	b.Grow(len(title))
+
+	for i := 0; i < len(runes); {
+		var letters []rune
+		j := i
+
+		for isSingleLetter(runes, j) {
+			if j+1 < len(runes) && runes[j+1] == '.' {
+				letters = append(letters, runes[j])
+				j += 2
+			} else if len(letters) > 0 && runes[j-1] == '.' {
+				// the last letter without a dot, directly after the previous one (Ex: "U.S.A")
+				letters = append(letters, runes[j])
+				j++
+				break
+			} else {
+				break
+			}
+
+			// initials separated by spaces, only upper case so words like "a" are not joined
+			k := j
+			for k < len(runes) && unicode.IsSpace(runes[k]) {
+				k++
+			}
+			if k > j && !(isSingleLetter(runes, k) && unicode.IsUpper(runes[k]) && unicode.IsUpper(letters[len(letters)-1]) && k+1 < len(runes) && runes[k+1] == '.') {
+				break
+			}
+			j = k
+		}
+
+		if len(letters) < 2 {
+			b.WriteRune(runes[i])
+			i++
+			continue
+		}
+
+		b.WriteString(string(letters))
+		if runes[j-1] == '.' {
+			// the dot was a separator too
+			b.WriteByte(' ')
+		}
+		i = j
+	}
+
+	return b.String()
+}
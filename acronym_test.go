@@ -0,0 +1,43 @@
+package slug
+
+import "testing"
+
+func TestSluggerAcronyms(t *testing.T) {
+	var list = []stringStruct{
+		{"", ""},
+		{"U.S.A. election", "usa-election"},
+		{"The U.S.A election", "the-usa-election"},
+		{"Made in the U.S.A.", "made-in-the-usa"},
+		{"U.S.A.election", "usa-election"},
+		{"J.R.R. Tolkien", "jrr-tolkien"},
+		{"J. R. R. Tolkien", "jrr-tolkien"},
+		{"George R. R. Martin", "george-rr-martin"},
+		{"J.K. Rowling", "jk-rowling"},
+		{"John F. Kennedy", "john-f-kennedy"}, // a single initial
+		{"e.g. this", "eg-this"},
+		{"i.e., that", "ie-that"},
+		{"a. b. c.", "a-b-c"}, // lower case letters with spaces are a list
+		{"Hello world. Goodbye world.", "hello-world-goodbye-world"},
+		{"Go 1.21.3 released", "go-1-21-3-released"},
+		{"v1.2.3", "v1-2-3"},
+		{"I. Introduction", "i-introduction"},
+		{"Ü.Ä.Ö.", "uao"},
+		{"www.example.com", "www-example-com"},
+		{"A.B.C.D.E.F.G.", "abcdefg"},
+		{"Plan B. A fresh start", "plan-b-a-fresh-start"},
+		{"Plan B. J. Smith", "plan-bj-smith"}, // the documented false positive
+	}
+
+	s := Slugger{Acronyms: true}
+
+	for _, l := range list {
+		if result := s.Slug(l.field); result != l.expectation {
+			t.Errorf("Slugger{Acronyms}.Slug(%v): Result[%s]. Expected: %s", l.field, result, l.expectation)
+		}
+	}
+
+	// without the option the dots are separators
+	if result := (Slugger{}).Slug("U.S.A. election"); result != "u-s-a-election" {
+		t.Errorf("Slugger{}.Slug(): Result[%s]. Expected: u-s-a-election", result)
+	}
+}
@@ -0,0 +1,65 @@
+package slug
+
+import (
+	"strings"
+	"unicode"
+)
+
+// what happens to apostrophes in a title
+type ApostropheMode int
+
+const (
+	ApostropheDrop       ApostropheMode = iota // removed and the words are joined like in GetAsciiSlug, "don't" -> "dont", "n'est" -> "nest"
+	ApostropheHyphen                           // a separator, "don't" -> "don-t", "n'est" -> "n-est"
+	ApostropheElision                          // a separator after an elided word of Language ("n'est" -> "n-est"), others are removed ("don't" -> "dont")
+	ApostropheDropElided                       // an elided word of Language is removed ("n'est pas" -> "est-pas"), others are removed
+)
+
+// straight and typographic apostrophes (’ ʼ), and the quotes, accents and primes typed in their place (‘ ´ ′)
+func isApostrophe(c rune) bool {
+	switch c {
+	case '\'', '\u2019', '\u02bc', '\u2018', '\u00b4', '\u2032':
+		return true
+	}
+	return false
+}
+
+// words that are elided before a vowel, by base language
+var elidedWords = map[string]map[string]bool{
+	"fr": {"c": true, "d": true, "j": true, "l": true, "m": true, "n": true, "s": true, "t": true,
+		"qu": true, "jusqu": true, "lorsqu": true, "puisqu": true, "quoiqu": true, "presqu": true},
+	"it": {"c": true, "d": true, "l": true, "m": true, "n": true, "s": true, "t": true, "v": true, "un": true,
+		"all": true, "dall": true, "dell": true, "nell": true, "sull": true, "coll": true, "quell": true, "quest": true, "sant": true, "bell": true},
+	"ca": {"d": true, "l": true, "m": true, "n": true, "s": true, "t": true},
+}
+
+func (s Slugger) replaceApostrophes(title string) string {
+	elided := elidedWords[languageBase(s.Language)]
+	runes := []rune(title)
+
+	out := make([]rune, 0, len(runes))
+	wordStart := 0 // index in out of the word before the current rune
+
+	for i, c := range runes {
+		if !isApostrophe(c) {
+			if !unicode.IsLetter(c) {
+				wordStart = len(out) + 1
+			}
+			out = append(out, c)
+			continue
+		}
+
+		word := strings.ToLower(string(out[wordStart:]))
+		isElided := elided[word] && i+1 < len(runes) && unicode.IsLetter(runes[i+1])
+
+		switch {
+		case s.Apostrophes == ApostropheHyphen, s.Apostrophes == ApostropheElision && isElided:
+			out = append(out, ' ')
+			wordStart = len(out)
+		case s.Apostrophes == ApostropheDropElided && isElided:
+			out = out[:wordStart]
+		}
+	}
+
+	return string(out)
+}
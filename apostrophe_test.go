@@ -0,0 +1,43 @@
+package slug
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestSluggerApostrophes(t *testing.T) {
+	var list = []struct {
+		field    string
+		lang     language.Tag
+		drop     string
+		hyphen   string
+		elision  string
+		dropElid string
+	}{
+		{"n'est pas", language.French, "nest-pas", "n-est-pas", "n-est-pas", "est-pas"},
+		{"n’est pas", language.French, "nest-pas", "n-est-pas", "n-est-pas", "est-pas"}, // typographic apostrophe
+		{"nʼest pas", language.French, "nest-pas", "n-est-pas", "n-est-pas", "est-pas"}, // modifier letter apostrophe
+		{"L'homme qu'il aime", language.French, "lhomme-quil-aime", "l-homme-qu-il-aime", "l-homme-qu-il-aime", "homme-il-aime"},
+		{"Aujourd'hui", language.French, "aujourdhui", "aujourd-hui", "aujourdhui", "aujourdhui"},
+		{"jusqu'à demain", language.MustParse("fr-CA"), "jusqua-demain", "jusqu-a-demain", "jusqu-a-demain", "a-demain"},
+		{"dell'anno", language.Italian, "dellanno", "dell-anno", "dell-anno", "anno"},
+		{"don't stop", language.English, "dont-stop", "don-t-stop", "dont-stop", "dont-stop"},
+		{"n'est pas", language.English, "nest-pas", "n-est-pas", "nest-pas", "nest-pas"}, // no elided words in English
+		{"students' books", language.English, "students-books", "students-books", "students-books", "students-books"},
+		{"l' espace", language.French, "l-espace", "l-espace", "l-espace", "l-espace"}, // not followed by a letter
+		{"rock ’n’ roll", language.English, "rock-n-roll", "rock-n-roll", "rock-n-roll", "rock-n-roll"},
+	}
+
+	for _, l := range list {
+		for mode, expectation := range map[ApostropheMode]string{
+			ApostropheDrop: l.drop, ApostropheHyphen: l.hyphen, ApostropheElision: l.elision, ApostropheDropElided: l.dropElid,
+		} {
+			s := Slugger{Apostrophes: mode, Language: l.lang}
+
+			if result := s.Slug(l.field); result != expectation {
+				t.Errorf("Slugger{%d, %s}.Slug(%v): Result[%s]. Expected: %s", mode, l.lang, l.field, result, expectation)
+			}
+		}
+	}
+}
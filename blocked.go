@@ -0,0 +1,150 @@
+package slug
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+/*
+	Words that should not appear in public slugs and tags, there is no built-in list.
+	Words are compared as ascii slugs, so accents and symbols cannot be used to hide them ("Bäd Wörd!" is the same as "bad-word").
+	By default only whole words match, a phrase matches a run of whole words. With Substrings set a word also matches inside other words.
+*/
+type BlockedWords struct {
+	Substrings bool
+
+	words     map[string]bool
+	maxTokens int // number of words in the longest phrase
+}
+
+func NewBlockedWords(words ...string) *BlockedWords {
+	b := &BlockedWords{words: make(map[string]bool)}
+	b.Add(words...)
+	return b
+}
+
+// reads a word list, one word or phrase per line, blank lines and lines starting with # are skipped
+func ReadBlockedWords(r io.Reader) (*BlockedWords, error) {
+	b := NewBlockedWords()
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if len(line) > 0 && line[0] != '#' {
+			b.Add(line)
+		}
+	}
+
+	return b, scanner.Err()
+}
+
+func (b *BlockedWords) Add(words ...string) {
+	for _, word := range words {
+		word = GetAsciiSlug(word)
+		if word == "" {
+			continue
+		}
+
+		b.words[word] = true
+		if n := strings.Count(word, "-") + 1; n > b.maxTokens {
+			b.maxTokens = n
+		}
+	}
+}
+
+// whether a slug contains a blocked word, it is slugged again like the words so "BAD-WORD" is the same as "bad-word"
+func (b *BlockedWords) Contains(sl string) bool {
+	return len(b.find(GetAsciiSlug(sl))) > 0
+}
+
+// byte ranges of the blocked words in an ascii slug, in order, ranges may overlap in substring mode
+func (b *BlockedWords) find(sl string) [][2]int {
+	var found [][2]int
+
+	if b.Substrings {
+		for word := range b.words {
+			for i := 0; i+len(word) <= len(sl); {
+				n := strings.Index(sl[i:], word)
+				if n < 0 {
+					break
+				}
+				found = append(found, [2]int{i + n, i + n + len(word)})
+				i += n + 1
+			}
+		}
+		return found
+	}
+
+	// start offsets of the words in the slug, plus the end of the slug
+	var starts []int
+	for i := 0; i < len(sl); i++ {
+		if i == 0 || sl[i-1] == '-' {
+			starts = append(starts, i)
+		}
+	}
+	starts = append(starts, len(sl)+1)
+
+	for i := 0; i < len(starts)-1; i++ {
+		for n := 1; n <= b.maxTokens && i+n < len(starts); n++ {
+			end := starts[i+n] - 1
+			if b.words[sl[starts[i]:end]] {
+				found = append(found, [2]int{starts[i], end})
+			}
+		}
+	}
+
+	return found
+}
+
+// what Slugger does with a slug or tag that contains a blocked word
+type BlockedAction int
+
+const (
+	BlockedReject BlockedAction = iota // the slug is blank and the tag is dropped
+	BlockedMask                        // the letters and numbers of the word are replaced with x (Ex: "bad-word" -> "xxx-xxxx")
+	BlockedRemove                      // the word is removed, a tag left without words is dropped
+)
+
+// applies the blocked action to the slug written after start in dst
+func (s Slugger) appendBlocked(dst []byte, start int) []byte {
+	if s.Blocked == nil {
+		return dst
+	}
+
+	sl := string(dst[start:])
+	found := s.Blocked.find(sl)
+
+	if len(found) == 0 {
+		return dst
+	}
+
+	switch s.OnBlocked {
+	case BlockedMask:
+		for _, r := range found {
+			for i := start + r[0]; i < start+r[1]; i++ {
+				if dst[i] != '-' {
+					dst[i] = 'x'
+				}
+			}
+		}
+	case BlockedRemove:
+		// blank the words with separators, AppendSlug collapses them and kept dots are restored
+		b := []byte(sl)
+		for _, r := range found {
+			for i := r[0]; i < r[1]; i++ {
+				b[i] = '-'
+			}
+		}
+		if s.keepsDots() {
+			dst = appendSlugWithDots(dst[:start], strings.Replace(string(b), ".", string(numberDot), -1))
+		} else {
+			dst = SlugBytes(dst[:start], b)
+		}
+	default:
+		dst = dst[:start]
+	}
+
+	return dst
+}
@@ -0,0 +1,140 @@
+package slug
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBlockedWordsContains(t *testing.T) {
+	whole := NewBlockedWords("darn", "Heck!", "gosh darn it", "~!@")
+	substrings := NewBlockedWords("darn", "heck")
+	substrings.Substrings = true
+
+	var list = []struct {
+		field      string
+		whole      bool
+		substrings bool
+	}{
+		{"darn", true, true},
+		{"oh-darn", true, true},
+		{"heck-yes", true, true},
+		{"darnit", false, true},
+		{"checked", false, true},
+		{"gosh-darn-it", true, true},
+		{"gosh-it", false, false},
+		{"hello-world", false, false},
+		{"", false, false},
+	}
+
+	for _, l := range list {
+		if result := whole.Contains(l.field); result != l.whole {
+			t.Errorf("Contains(%v): Result[%t]. Expected: %t", l.field, result, l.whole)
+		}
+		if result := substrings.Contains(l.field); result != l.substrings {
+			t.Errorf("Contains(%v) substrings: Result[%t]. Expected: %t", l.field, result, l.substrings)
+		}
+	}
+}
+
+func TestReadBlockedWords(t *testing.T) {
+	b, err := ReadBlockedWords(strings.NewReader("# comment\n\ndarn\n  Gosh Heck  \n"))
+	if err != nil {
+		t.Fatalf("ReadBlockedWords(): %s", err.Error())
+	}
+
+	if !b.Contains("darn") || !b.Contains("gosh-heck") || b.Contains("gosh") || b.Contains("comment") {
+		t.Errorf("ReadBlockedWords(): unexpected words %v", b.words)
+	}
+}
+
+func TestSluggerBlocked(t *testing.T) {
+	whole := NewBlockedWords("darn", "gosh heck")
+	substrings := NewBlockedWords("darn")
+	substrings.Substrings = true
+
+	var list = []struct {
+		field   string
+		blocked *BlockedWords
+		action  BlockedAction
+		result  string
+	}{
+		{"Hello world", whole, BlockedReject, "hello-world"},
+		{"Oh darn it", whole, BlockedReject, ""},
+		{"Oh DÄRN it", whole, BlockedMask, "oh-xxxx-it"}, // accents do not hide the word
+		{"Oh d.a.r.n it", whole, BlockedMask, "oh-d-a-r-n-it"},
+		{"Oh darn it", whole, BlockedRemove, "oh-it"},
+		{"Darn", whole, BlockedRemove, ""},
+		{"Gosh, heck!", whole, BlockedMask, "xxxx-xxxx"},
+		{"Gosh heck no", whole, BlockedRemove, "no"},
+		{"Darnit all", whole, BlockedMask, "darnit-all"},
+		{"Darnit all", substrings, BlockedMask, "xxxxit-all"},
+		{"Darnit all", substrings, BlockedRemove, "it-all"},
+		{"Darnit all", substrings, BlockedReject, ""},
+	}
+
+	for _, l := range list {
+		s := Slugger{Blocked: l.blocked, OnBlocked: l.action}
+
+		if result := s.Slug(l.field); result != l.result {
+			t.Errorf("Slugger{%d}.Slug(%q): Result[%s]. Expected: %s", l.action, l.field, result, l.result)
+		}
+	}
+
+	s := Slugger{Blocked: whole}
+	if err := s.Validate("oh-darn-it"); err != ErrBlockedSlug {
+		t.Errorf("Slugger.Validate(oh-darn-it): Result[%v]. Expected: %v", err, ErrBlockedSlug)
+	}
+	if err := s.Validate("darnit"); err != nil {
+		t.Errorf("Slugger.Validate(darnit): Result[%v]. Expected: <nil>", err)
+	}
+
+	// IsSlug accepts upper case, the case must not hide a word
+	for _, sl := range []string{"Oh-DARN-it", "DARN", "Darn-It"} {
+		if err := s.Validate(sl); err != ErrBlockedSlug {
+			t.Errorf("Slugger.Validate(%s): Result[%v]. Expected: %v", sl, err, ErrBlockedSlug)
+		}
+	}
+	if err := (Slugger{Blocked: NewBlockedWords("bad word")}).Validate("BAD-WORD"); err != ErrBlockedSlug {
+		t.Errorf("Slugger.Validate(BAD-WORD): Result[%v]. Expected: %v", err, ErrBlockedSlug)
+	}
+}
+
+func TestSluggerGetTagsAndTagSlugs(t *testing.T) {
+	blocked := NewBlockedWords("darn")
+	tags := "Go,Darn it,darn,golang,GO,admin, Dárn"
+
+	var list = []struct {
+		action BlockedAction
+		tags   []string
+		slugs  []string
+	}{
+		{BlockedReject, []string{"Go", "golang", "admin"}, []string{"go", "golang", "admin"}},
+		{BlockedMask, []string{"Go", "xxxx it", "xxxx", "golang", "admin"}, []string{"go", "xxxx-it", "xxxx", "golang", "admin"}},
+		{BlockedRemove, []string{"Go", "it", "golang", "admin"}, []string{"go", "it", "golang", "admin"}},
+	}
+
+	for _, l := range list {
+		// reserved words do not apply to tags
+		s := Slugger{Blocked: blocked, OnBlocked: l.action, Reserved: DefaultReservedWords}
+		tagList, slugList := s.GetTagsAndTagSlugs(tags)
+
+		if !sliceEqual(tagList, l.tags) || !sliceEqual(slugList, l.slugs) {
+			t.Errorf("Slugger{%d}.GetTagsAndTagSlugs(): Result[%q %q]. Expected: %q %q", l.action, tagList, slugList, l.tags, l.slugs)
+		}
+	}
+
+	// without options it is the same as the package function
+	tagList, slugList := (Slugger{}).GetTagsAndTagSlugs(tags)
+	expectedTags, expectedSlugs := GetTagsAndTagSlugs(tags)
+	if !sliceEqual(tagList, expectedTags) || !sliceEqual(slugList, expectedSlugs) {
+		t.Errorf("Slugger{}.GetTagsAndTagSlugs(): Result[%q %q]. Expected: %q %q", tagList, slugList, expectedTags, expectedSlugs)
+	}
+}
+
+func Test_IsSlugFieldBlocked(t *testing.T) {
+	s := Slugger{Blocked: NewBlockedWords("darn"), OnBlocked: BlockedMask}
+
+	if e, _ := s.IsSlugField().Validate([]string{"Oh darn it"}, make(map[string]string)); e != ErrBlockedSlug {
+		t.Errorf("IsSlugField(Oh darn it): Result[%v]. Expected: %v", e, ErrBlockedSlug)
+	}
+}
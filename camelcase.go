@@ -0,0 +1,87 @@
+package slug
+
+import (
+	"strings"
+	"unicode"
+)
+
+// brand and product names that are not split on their case transitions
+var DefaultKeepWords = []string{
+	"iPhone", "iPad", "iPod", "iMac", "iOS", "iPadOS", "macOS", "tvOS", "watchOS", "iCloud", "iTunes",
+	"eBay", "eBook", "eCommerce", "YouTube", "GitHub", "GitLab", "PayPal", "LinkedIn", "WordPress", "WhatsApp",
+	"JavaScript", "TypeScript", "CoffeeScript", "PostgreSQL", "MySQL", "SQLite", "MongoDB", "GraphQL", "OAuth",
+	"PlayStation", "PowerPoint", "McDonald", "DevOps",
+}
+
+/*
+	Inserts a space at the case transitions of title (Ex: "HTTPServer" -> "HTTP Server", "userID" -> "user ID").
+	A word starts at an upper case letter after a lower case letter or digit, and at the last upper case letter of an acronym
+	followed by a lower case letter. The keep words are matched exactly where a word starts, so "NoteBook" does not hold "eBook",
+	they are not split but are separated from the words around them.
+*/
+func splitCaseTransitions(title string, keep []string) string {
+	runes := []rune(title)
+
+	// runes inside a keep word, and the runes that start one
+	protected := make([]bool, len(runes))
+	starts := make([]bool, len(runes))
+
+	for _, word := range keep {
+		w := []rune(word)
+		if len(w) == 0 {
+			continue
+		}
+
+		for i := 0; i+len(w) <= len(runes); i++ {
+			if string(runes[i:i+len(w)]) != word || !isWordStart(runes, i) {
+				continue
+			}
+
+			starts[i] = true
+			for j := i + 1; j < i+len(w); j++ {
+				protected[j] = true
+			}
+			i += len(w) - 1
+		}
+	}
+
+	var b strings.Builder
+	b.Grow(len(title) + 8)
+
+	for i, c := range runes {
+		if i > 0 && !protected[i] {
+			prev := runes[i-1]
+			boundary := false
+
+			switch {
+			case starts[i]:
+				boundary = unicode.IsLetter(prev) || unicode.IsDigit(prev)
+			case unicode.IsUpper(c) && (unicode.IsLower(prev) || unicode.IsDigit(prev)):
+				boundary = true
+			case unicode.IsUpper(c) && protected[i-1]:
+				// the end of a keep word followed by a new word (Ex: "PostgreSQLDriver")
+				boundary = true
+			case unicode.IsUpper(c) && unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1]):
+				boundary = true
+			}
+
+			if boundary {
+				b.WriteByte(' ')
+			}
+		}
+
+		b.WriteRune(c)
+	}
+
+	return b.String()
+}
+
+// whether a word starts at runes[i] before the keep words are applied: at the start, after a non-letter or at a case transition
+func isWordStart(runes []rune, i int) bool {
+	if i == 0 || !unicode.IsLetter(runes[i-1]) {
+		return true
+	}
+
+	c, prev := runes[i], runes[i-1]
+	return unicode.IsUpper(c) && (unicode.IsLower(prev) || (i+1 < len(runes) && unicode.IsLower(runes[i+1])))
+}
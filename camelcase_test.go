@@ -0,0 +1,55 @@
+package slug
+
+import "testing"
+
+func TestSluggerSplitCase(t *testing.T) {
+	var list = []stringStruct{
+		{"", ""},
+		{"Hello World", "hello-world"},
+		{"HTTPServer", "http-server"},
+		{"iPhoneApps", "iphone-apps"},
+		{"Best iPhone apps", "best-iphone-apps"},
+		{"My iPhone", "my-iphone"},
+		{"MyiPhone", "myi-phone"}, // a keep word inside a word is not matched
+		{"userID", "user-id"},
+		{"getHTTPResponseCode", "get-http-response-code"},
+		{"XMLHttpRequest", "xml-http-request"},
+		{"mp3Player", "mp3-player"},
+		{"HTML5", "html5"},
+		{"ABC", "abc"},
+		{"PostgreSQLDriver", "postgresql-driver"},
+		{"JavaScriptOnGitHub", "javascript-on-github"},
+		{"eBay", "ebay"},
+		{"iPhones", "iphones"},
+		{"ÜberCoolÄpfel", "uber-cool-apfel"},
+		{"snake_caseWord", "snake-case-word"},
+		{"NoteBook", "note-book"},
+		{"PhoneBookApp", "phone-book-app"},
+		{"FreeBook", "free-book"},
+		{"SeeBay", "see-bay"},
+		{"Buy_eBook", "buy-ebook"},
+	}
+
+	s := Slugger{SplitCase: true}
+
+	for _, l := range list {
+		if result := s.Slug(l.field); result != l.expectation {
+			t.Errorf("Slugger{SplitCase}.Slug(%v): Result[%s]. Expected: %s", l.field, result, l.expectation)
+		}
+	}
+
+	// a custom list replaces the default one
+	s.KeepWords = []string{"GoLand"}
+	if result := s.Slug("GoLand for iPhone"); result != "goland-for-i-phone" {
+		t.Errorf("Slugger{KeepWords}.Slug(): Result[%s]. Expected: goland-for-i-phone", result)
+	}
+
+	// the option also applies to identifiers and tags
+	s.KeepWords = nil
+	if result := s.SnakeCase("HTTPServer"); result != "http_server" {
+		t.Errorf("Slugger{SplitCase}.SnakeCase(): Result[%s]. Expected: http_server", result)
+	}
+	if _, slugs := s.GetTagsAndTagSlugs("iPhoneApps,GoLang"); !sliceEqual(slugs, []string{"iphone-apps", "go-lang"}) {
+		t.Errorf("Slugger{SplitCase}.GetTagsAndTagSlugs(): Result[%q]", slugs)
+	}
+}
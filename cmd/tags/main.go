@@ -0,0 +1,184 @@
+/*
+Command tags normalizes a tag column of a CSV file.
+
+The chosen column is run through slug.ParseTags and two new columns are appended
+to every row, one with the display tags and one with the tag slugs, the same as
+slug.GetTagsAndTagSlugs. Tags that are dropped (nothing left after slugging) or
+merged (duplicate slug) are written to a separate report so they can be reviewed
+after a migration.
+
+Rows with fewer fields than the header are padded with empty fields, rows with
+more fields are rejected with their line number.
+
+The input is streamed one record at a time, so very large files can be processed.
+
+Usage:
+
+	tags -column tags [-in file.csv] [-out new.csv] [-report report.csv]
+*/
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/dholtzmann/slug"
+)
+
+// settings taken from the command line
+type options struct {
+	column     string
+	tagHeader  string
+	slugHeader string
+	comma      rune
+}
+
+func main() {
+	var opt options
+	var in, out, report, comma string
+
+	flag.StringVar(&opt.column, "column", "", "name of the column holding the tags (required)")
+	flag.StringVar(&in, "in", "", "input CSV file (default stdin)")
+	flag.StringVar(&out, "out", "", "output CSV file (default stdout)")
+	flag.StringVar(&report, "report", "", "report CSV file for dropped and merged tags (default stderr)")
+	flag.StringVar(&opt.tagHeader, "tag-header", "display_tags", "header of the new display tag column")
+	flag.StringVar(&opt.slugHeader, "slug-header", "slug_tags", "header of the new slug tag column")
+	flag.StringVar(&comma, "comma", ",", "field separator of the input and output CSV")
+	flag.Parse()
+
+	if opt.column == "" || len([]rune(comma)) != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	opt.comma = []rune(comma)[0]
+
+	var r io.Reader = os.Stdin
+	var w, rep io.Writer = os.Stdout, os.Stderr
+
+	if in != "" {
+		f, err := os.Open(in)
+		if err != nil {
+			fatal(err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	if out != "" {
+		f, err := os.Create(out)
+		if err != nil {
+			fatal(err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if report != "" {
+		f, err := os.Create(report)
+		if err != nil {
+			fatal(err)
+		}
+		defer f.Close()
+		rep = f
+	}
+
+	if err := process(r, w, rep, opt); err != nil {
+		fatal(err)
+	}
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "tags:", err)
+	os.Exit(1)
+}
+
+// reads the CSV record by record and writes the normalized copy and the report
+func process(in io.Reader, out, report io.Writer, opt options) error {
+	r := csv.NewReader(in)
+	r.Comma = opt.comma
+	r.FieldsPerRecord = -1
+	r.ReuseRecord = true
+
+	w := csv.NewWriter(out)
+	w.Comma = opt.comma
+
+	rep := csv.NewWriter(report)
+
+	header, err := r.Read()
+	if err == io.EOF {
+		return errors.New("empty input, a header row is required")
+	}
+	if err != nil {
+		return err
+	}
+
+	column := -1
+	for i, name := range header {
+		if name == opt.column {
+			column = i
+			break
+		}
+	}
+	if column < 0 {
+		return fmt.Errorf("column %q not found in header", opt.column)
+	}
+
+	if err := w.Write(append(header, opt.tagHeader, opt.slugHeader)); err != nil {
+		return err
+	}
+	if err := rep.Write([]string{"line", "tag", "action", "slug"}); err != nil {
+		return err
+	}
+
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		line, _ := r.FieldPos(0)
+
+		// the new columns must line up with the header
+		if len(record) > len(header) {
+			return fmt.Errorf("line %d: %d fields, the header has %d", line, len(record), len(header))
+		}
+		for len(record) < len(header) {
+			record = append(record, "")
+		}
+
+		var tags, slugs []string
+
+		for _, entry := range slug.ParseTags(record[column]) {
+			switch entry.Action {
+			case slug.TagKept:
+				tags = append(tags, entry.Tag)
+				slugs = append(slugs, entry.Slug)
+			default:
+				if err := rep.Write([]string{strconv.Itoa(line), entry.Tag, entry.Action.String(), entry.Slug}); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := w.Write(append(record, strings.Join(tags, slug.DELIMITER), strings.Join(slugs, slug.DELIMITER))); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return err
+	}
+
+	rep.Flush()
+	return rep.Error()
+}
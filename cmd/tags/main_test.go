@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestProcess(t *testing.T) {
+	in := "id,tags\n" +
+		"1,\"Go,golang,GO,,~!@\"\n" +
+		"2,\n" +
+		"3\n"
+
+	var out, report bytes.Buffer
+	opt := options{column: "tags", tagHeader: "display_tags", slugHeader: "slug_tags", comma: ','}
+
+	if err := process(strings.NewReader(in), &out, &report, opt); err != nil {
+		t.Fatalf("process(): %s", err.Error())
+	}
+
+	expected := "id,tags,display_tags,slug_tags\n" +
+		"1,\"Go,golang,GO,,~!@\",\"Go,golang\",\"go,golang\"\n" +
+		"2,,,\n" +
+		"3,,,\n"
+	if out.String() != expected {
+		t.Errorf("process(): output\n%s\nExpected:\n%s", out.String(), expected)
+	}
+
+	expected = "line,tag,action,slug\n" +
+		"2,GO,merged,go\n" +
+		"2,~!@,dropped,\n"
+	if report.String() != expected {
+		t.Errorf("process(): report\n%s\nExpected:\n%s", report.String(), expected)
+	}
+}
+
+func TestProcessRaggedRows(t *testing.T) {
+	opt := options{column: "tags", tagHeader: "display_tags", slugHeader: "slug_tags", comma: ','}
+
+	// short rows are padded, so the new columns stay under their headers
+	var out, report bytes.Buffer
+	in := "id,tags,note\n" +
+		"1,\"a,b\"\n"
+
+	if err := process(strings.NewReader(in), &out, &report, opt); err != nil {
+		t.Fatalf("process(): %s", err.Error())
+	}
+
+	expected := "id,tags,note,display_tags,slug_tags\n" +
+		"1,\"a,b\",,\"a,b\",\"a,b\"\n"
+	if out.String() != expected {
+		t.Errorf("process(): output\n%s\nExpected:\n%s", out.String(), expected)
+	}
+
+	// long rows are rejected with their line number
+	out.Reset()
+	report.Reset()
+	in = "id,tags,note\n" +
+		"1,a,x\n" +
+		"2,c,x,extra\n"
+
+	err := process(strings.NewReader(in), &out, &report, opt)
+	if err == nil || !strings.Contains(err.Error(), "line 3") {
+		t.Errorf("process(): Error[%v]. Expected an error for line 3", err)
+	}
+}
+
+func TestProcessMissingColumn(t *testing.T) {
+	var out, report bytes.Buffer
+	opt := options{column: "tags", comma: ','}
+
+	if err := process(strings.NewReader("id,name\n1,a\n"), &out, &report, opt); err == nil {
+		t.Errorf("process(): expected an error for a missing column")
+	}
+
+	if err := process(strings.NewReader(""), &out, &report, opt); err == nil {
+		t.Errorf("process(): expected an error for empty input")
+	}
+}
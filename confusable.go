@@ -0,0 +1,121 @@
+package slug
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+/*
+	Characters that look like Latin letters, mapped to the lowercase Latin prototype.
+	A subset of the Unicode confusables (UTS #39) for the scripts most used for spoofing: Cyrillic, Greek, Armenian,
+	and Latin letters and digits that look alike. Full-width and other compatibility forms are folded by NFKD first.
+*/
+var confusables = map[rune]string{
+	// Cyrillic
+	'а': "a", 'в': "b", 'е': "e", 'һ': "h", 'і': "i", 'ј': "j", 'к': "k", 'м': "rn", 'н': "h", 'о': "o", 'р': "p",
+	'с': "c", 'т': "t", 'у': "y", 'х': "x", 'ѕ': "s", 'ԁ': "cl", 'ԛ': "q", 'ԝ': "vv", 'ӏ': "l", 'ү': "y", 'ѵ': "v",
+	'ь': "b", 'п': "n", 'г': "r", 'з': "3", 'б': "6", 'ѡ': "vv", 'ԍ': "g",
+	'А': "a", 'В': "b", 'Е': "e", 'Ѕ': "s", 'І': "l", 'Ј': "j", 'К': "k", 'М': "rn", 'Н': "h", 'О': "o", 'Р': "p",
+	'С': "c", 'Т': "t", 'У': "y", 'Х': "x", 'Ԁ': "cl", 'Ԛ': "q", 'Ԝ': "vv", 'Ӏ': "l", 'Ү': "y", 'З': "3", 'Ь': "b",
+	// Greek
+	'α': "a", 'β': "b", 'γ': "y", 'ε': "e", 'ι': "i", 'κ': "k", 'ν': "v", 'ο': "o", 'ρ': "p", 'σ': "o", 'τ': "t", 'υ': "u",
+	'χ': "x", 'ω': "w", 'ϲ': "c", 'ϳ': "j",
+	'Α': "a", 'Β': "b", 'Ε': "e", 'Ζ': "z", 'Η': "h", 'Ι': "l", 'Κ': "k", 'Μ': "rn", 'Ν': "n", 'Ο': "o", 'Ρ': "p",
+	'Τ': "t", 'Υ': "y", 'Χ': "x", 'Ϲ': "c", 'Ϳ': "j",
+	// Armenian
+	'օ': "o", 'ս': "u", 'ց': "g", 'հ': "h", 'ո': "n", 'զ': "q", 'ա': "w",
+	// Latin and digits
+	'I': "l", '1': "l", '|': "l", 'ǀ': "l", 'ı': "i", 'ɩ': "i", '0': "o", 'm': "rn", 'M': "rn", 'w': "vv", 'W': "vv",
+	'd': "cl", 'D': "cl", 'ſ': "f", 'ɑ': "a", 'ɡ': "g",
+}
+
+/*
+	The confusable skeleton of s, as in UTS #39: two strings with the same skeleton look alike ("pаypal" with a Cyrillic "а" and "paypal").
+	Case is ignored, since slugs are compared without case. Store the skeleton of every slug to find new slugs that collide visually.
+*/
+func Skeleton(s string) string {
+	s = norm.NFKD.String(s)
+
+	var b strings.Builder
+	b.Grow(len(s))
+
+	for _, c := range s {
+		if prototype, found := confusables[c]; found {
+			b.WriteString(prototype)
+		} else {
+			b.WriteRune(unicode.ToLower(c))
+		}
+	}
+
+	return norm.NFD.String(b.String())
+}
+
+func IsConfusable(a, b string) bool {
+	return Skeleton(a) == Skeleton(b)
+}
+
+// the scripts a letter can be written in, Han is also part of Japanese and Korean writing
+func scriptSet(c rune) []string {
+	switch {
+	case c < 0x80 || unicode.Is(unicode.Latin, c):
+		return []string{"Latin"}
+	case unicode.Is(unicode.Han, c):
+		return []string{"Han", "Japanese", "Korean"}
+	case unicode.Is(unicode.Hiragana, c), unicode.Is(unicode.Katakana, c):
+		return []string{"Japanese"}
+	case unicode.Is(unicode.Hangul, c):
+		return []string{"Korean"}
+	case unicode.Is(unicode.Bopomofo, c):
+		return []string{"Han"}
+	}
+
+	for name, table := range unicode.Scripts {
+		if name != "Common" && name != "Inherited" && unicode.Is(table, c) {
+			return []string{name}
+		}
+	}
+	return nil
+}
+
+/*
+	Whether the letters of s come from more than one script (Ex: Latin "p" with Cyrillic "а").
+	Digits, punctuation and combining marks belong to every script, and Han may be mixed with Hiragana and Katakana (Japanese)
+	or Hangul (Korean), like the resolved script set of UTS #39.
+*/
+func IsMixedScript(s string) bool {
+	var resolved []string
+
+	for _, c := range s {
+		if !unicode.IsLetter(c) {
+			continue
+		}
+
+		set := scriptSet(c)
+		if set == nil {
+			continue
+		}
+
+		if resolved == nil {
+			resolved = set
+			continue
+		}
+
+		var common []string
+		for _, name := range resolved {
+			for _, other := range set {
+				if name == other {
+					common = append(common, name)
+				}
+			}
+		}
+
+		if len(common) == 0 {
+			return true
+		}
+		resolved = common
+	}
+
+	return false
+}
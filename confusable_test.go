@@ -0,0 +1,88 @@
+package slug
+
+import (
+	"testing"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestIsMixedScript(t *testing.T) {
+	var list = []defaultStruct{
+		{"", false},
+		{"paypal", false},
+		{"pаypal", true}, // Cyrillic а
+		{"раураl", true}, // Cyrillic р а у а with a Latin l
+		{"рау", false},   // all Cyrillic, a whole script confusable, see Skeleton
+		{"αβγ-123", false},
+		{"hello-κόσμε", true},
+		{"東京-とうきょう-トウキョウ", false}, // Han, Hiragana and Katakana are Japanese
+		{"서울-首爾", false},          // Hangul and Han are Korean
+		{"とうきょう-서울", true},
+		{"cafe\u0301", false}, // combining marks belong to every script
+		{"straße-2017", false},
+		{"hello-мир", true},
+	}
+
+	for _, l := range list {
+		if result := IsMixedScript(l.field); result != l.expectation {
+			t.Errorf("IsMixedScript(%v): Result[%t]. Expected: %t", l.field, result, l.expectation)
+		}
+	}
+}
+
+func TestSkeleton(t *testing.T) {
+	var list = []struct {
+		a, b        string
+		expectation bool
+	}{
+		{"paypal", "pаypal", true}, // Cyrillic а
+		{"paypal", "рауpal", true}, // Cyrillic р а у
+		{"paypal", "PayPal", true},
+		{"paypal", "ｐａｙｐａｌ", true}, // full-width
+		{"google", "g00gle", true},
+		{"apple", "app1e", true},
+		{"modern", "rnodern", true},
+		{"www", "vvvvvv", true},
+		{"cloud", "cIoud", true},
+		{"epic", "εpic", true}, // Greek ε
+		{"scope", "ѕсоре", true},
+		{"paypal", "paypa", false},
+		{"cafe", "café", false}, // accents are visible
+		{"cafe", "cafe\u0301", false},
+		{"café", "cafe\u0301", true}, // composed and decomposed
+		{"hello-world", "hello-word", false},
+	}
+
+	for _, l := range list {
+		if result := IsConfusable(l.a, l.b); result != l.expectation {
+			t.Errorf("IsConfusable(%v, %v): Result[%t] %q %q. Expected: %t", l.a, l.b, result, Skeleton(l.a), Skeleton(l.b), l.expectation)
+		}
+	}
+}
+
+func TestIsUTF8SlugSingleScript(t *testing.T) {
+	opt := UTF8Options{Normalize: true, Form: norm.NFC, SingleScript: true}
+
+	var list = []defaultStruct{
+		{"paypal", true},
+		{"pаypal", false},
+		{"привет-мир", true},
+		{"привет-world", false},
+		{"東京-とうきょう", true},
+	}
+
+	for _, l := range list {
+		if result := IsUTF8SlugWith(l.field, opt); result != l.expectation {
+			t.Errorf("IsUTF8SlugWith(%v, SingleScript): Valid[%t]. Expected: %t", l.field, result, l.expectation)
+		}
+	}
+
+	if IsUTF8ItemTagWith("pаypal tag", opt) || !IsUTF8ItemTagWith("привет мир", opt) {
+		t.Errorf("IsUTF8ItemTagWith(SingleScript): mixed scripts were not detected")
+	}
+
+	// without the option mixed scripts are allowed
+	if !IsUTF8SlugWith("pаypal", UTF8Options{}) {
+		t.Errorf("IsUTF8SlugWith(pаypal): Valid[false]. Expected: true")
+	}
+}
@@ -0,0 +1,47 @@
+package slug
+
+import (
+	"strings"
+	"unicode"
+)
+
+// the ASCII digit for a Unicode decimal digit, the ranges of unicode.Nd always hold whole sets of ten digits from 0 to 9
+func asciiDigit(c rune) (byte, bool) {
+	if c < 0x80 {
+		return byte(c), isDigit(c)
+	}
+
+	for _, r := range unicode.Nd.R16 {
+		if c >= rune(r.Lo) && c <= rune(r.Hi) {
+			return '0' + byte((c-rune(r.Lo))%10), true
+		}
+	}
+
+	for _, r := range unicode.Nd.R32 {
+		if c >= rune(r.Lo) && c <= rune(r.Hi) {
+			return '0' + byte((c-rune(r.Lo))%10), true
+		}
+	}
+
+	return 0, false
+}
+
+// replaces decimal digits of any script with ASCII digits (Ex: Arabic-Indic "٣" and Devanagari "३" -> "3"), the transliteration drops most of them
+func FoldDigits(s string) string {
+	if isAsciiString(s) {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+
+	for _, c := range s {
+		if d, ok := asciiDigit(c); ok {
+			b.WriteByte(d)
+		} else {
+			b.WriteRune(c)
+		}
+	}
+
+	return b.String()
+}
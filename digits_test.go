@@ -0,0 +1,93 @@
+package slug
+
+import (
+	"testing"
+	"unicode"
+)
+
+func TestFoldDigits(t *testing.T) {
+	var list = []stringStruct{
+		{"", ""},
+		{"abc 123", "abc 123"},
+		{"٠١٢٣٤٥٦٧٨٩", "0123456789"}, // Arabic-Indic
+		{"۰۱۲۳۴۵۶۷۸۹", "0123456789"}, // Extended Arabic-Indic (Persian)
+		{"०१२३४५६७८९", "0123456789"}, // Devanagari
+		{"০১২৩", "0123"},             // Bengali
+		{"๑๒๓", "123"},               // Thai
+		{"０１２３", "0123"},             // full-width
+		{"𝟎𝟏𝟐𝟑𝟗", "01239"},           // mathematical bold
+		{"सन् २०१७", "सन् 2017"},
+		{"½ ² ①", "½ ² ①"}, // not decimal digits
+	}
+
+	for _, l := range list {
+		if result := FoldDigits(l.field); result != l.expectation {
+			t.Errorf("FoldDigits(%v): Result[%s]. Expected: %s", l.field, result, l.expectation)
+		}
+	}
+}
+
+// every decimal digit folds to a digit with the same numeric value as its position in its set of ten
+func TestFoldDigitsAllScripts(t *testing.T) {
+	for c := rune(0); c <= unicode.MaxRune; c++ {
+		if !unicode.Is(unicode.Nd, c) {
+			continue
+		}
+
+		d, ok := asciiDigit(c)
+		if !ok {
+			t.Fatalf("asciiDigit(%U): not a digit", c)
+		}
+
+		// the digit before a zero is not part of the same set
+		if d != '0' {
+			if prev, _ := asciiDigit(c - 1); prev != d-1 {
+				t.Errorf("asciiDigit(%U): Result[%c] after %c", c, d, prev)
+			}
+		}
+	}
+}
+
+func TestSluggerFoldDigits(t *testing.T) {
+	var list = []stringStruct{
+		{"Chapter ٣", "chapter-3"},
+		{"अध्याय ३", "adhyaay-3"},
+		{"๑๒๓ go", "123-go"},
+	}
+
+	for _, l := range list {
+		if result := (Slugger{FoldDigits: true}).Slug(l.field); result != l.expectation {
+			t.Errorf("Slugger{FoldDigits}.Slug(%v): Result[%s]. Expected: %s", l.field, result, l.expectation)
+		}
+	}
+}
+
+func TestIsUTF8SlugDigits(t *testing.T) {
+	var list = []struct {
+		field  string
+		plain  bool
+		digits bool
+	}{
+		{"فصل-٣", false, true},
+		{"अध्याय-३", false, true},
+		{"chapter-3", true, true},
+		{"٣٣٣", false, true},
+		{"chapter-½", false, false},
+		{"chapter-²", false, false},
+	}
+
+	digits := UTF8Options{Normalize: true, Digits: true}
+
+	for _, l := range list {
+		if result := IsUTF8SlugWith(l.field, UTF8Options{Normalize: true}); result != l.plain {
+			t.Errorf("IsUTF8SlugWith(%v): Valid[%t]. Expected: %t", l.field, result, l.plain)
+		}
+		if result := IsUTF8SlugWith(l.field, digits); result != l.digits {
+			t.Errorf("IsUTF8SlugWith(%v, Digits): Valid[%t]. Expected: %t", l.field, result, l.digits)
+		}
+	}
+
+	if !IsUTF8ItemTagWith("فصل ٣", digits) || IsUTF8ItemTagWith("فصل ٣", UTF8Options{}) {
+		t.Errorf("IsUTF8ItemTagWith(Digits): Arabic-Indic digits were not handled")
+	}
+}
@@ -0,0 +1,143 @@
+package slug
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// what happens to emoji in a title before it is transliterated
+type EmojiMode int
+
+const (
+	EmojiTransliterate EmojiMode = iota // left to the transliteration like GetAsciiSlug, most emoji are dropped
+	EmojiWords                          // replaced with their CLDR short names, "I ❤ Go 🚀" -> "i-red-heart-go-rocket"
+	EmojiStrip                          // removed completely, including keycaps, flags and sequences
+)
+
+const (
+	zeroWidthJoiner = '\u200d'
+	keycap          = '\u20e3'
+)
+
+// first runes of the emoji in the table, for a quick check before reading a sequence
+var emojiStart map[rune]bool
+
+func init() {
+	emojiStart = make(map[rune]bool)
+	for seq := range emojiNames {
+		c, _ := utf8.DecodeRuneInString(seq)
+		emojiStart[c] = true
+	}
+}
+
+// variation selectors and skin tone modifiers, they do not change the name of an emoji
+func isEmojiModifier(c rune) bool {
+	return c == '\ufe0e' || c == '\ufe0f' || (c >= 0x1f3fb && c <= 0x1f3ff)
+}
+
+func isRegionalIndicator(c rune) bool {
+	return c >= 0x1f1e6 && c <= 0x1f1ff
+}
+
+// tag characters used by subdivision flags (Ex: England)
+func isEmojiTag(c rune) bool {
+	return c >= 0xe0020 && c <= 0xe007f
+}
+
+/*
+	Returns the length in bytes of the emoji sequence at the start of s, or 0 when there is none.
+	Symbols below U+2300 (©, ®, ™, arrows, keycap digits) are text by default, they only count when followed by U+FE0F or a keycap.
+*/
+func emojiLength(s string) int {
+	c, n := utf8.DecodeRuneInString(s)
+	next, m := utf8.DecodeRuneInString(s[n:])
+
+	switch {
+	case isEmojiModifier(c) || c == zeroWidthJoiner || isEmojiTag(c) || c == keycap:
+		// a stray modifier without an emoji
+		return n
+	case isRegionalIndicator(c):
+		if isRegionalIndicator(next) {
+			return n + m
+		}
+		return n
+	case !emojiStart[c]:
+		return 0
+	case c < 0x2300 && next != '\ufe0f' && next != keycap:
+		return 0
+	}
+
+	// the rest of the sequence, modifiers and anything joined with a zero width joiner
+	for n < len(s) {
+		c, m = utf8.DecodeRuneInString(s[n:])
+
+		if isEmojiModifier(c) || isEmojiTag(c) || c == keycap {
+			n += m
+			continue
+		}
+
+		if c == zeroWidthJoiner {
+			if next, _ := utf8.DecodeRuneInString(s[n+m:]); emojiStart[next] {
+				n += m + utf8.RuneLen(next)
+				continue
+			}
+		}
+
+		break
+	}
+
+	return n
+}
+
+// the name of an emoji sequence, a sequence missing from the table is named by its parts
+func emojiName(seq string) string {
+	key := strings.Map(func(c rune) rune {
+		if isEmojiModifier(c) {
+			return -1
+		}
+		return c
+	}, seq)
+
+	if name, found := emojiNames[key]; found {
+		return name
+	}
+
+	var names []string
+	for _, part := range strings.Split(key, string(zeroWidthJoiner)) {
+		if name, found := emojiNames[part]; found {
+			names = append(names, name)
+		}
+	}
+
+	return strings.Join(names, " ")
+}
+
+// replaces or removes the emoji in title, names are surrounded by spaces so they become separate words
+func replaceEmoji(title string, mode EmojiMode) string {
+	if mode == EmojiTransliterate || isAsciiString(title) {
+		return title
+	}
+
+	var b strings.Builder
+	b.Grow(len(title))
+
+	for i := 0; i < len(title); {
+		n := emojiLength(title[i:])
+
+		if n == 0 {
+			_, size := utf8.DecodeRuneInString(title[i:])
+			b.WriteString(title[i : i+size])
+			i += size
+			continue
+		}
+
+		if mode == EmojiWords {
+			if name := emojiName(title[i : i+n]); name != "" {
+				b.WriteString(" " + name + " ")
+			}
+		}
+		i += n
+	}
+
+	return b.String()
+}
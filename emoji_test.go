@@ -0,0 +1,44 @@
+package slug
+
+import "testing"
+
+func TestSluggerEmoji(t *testing.T) {
+	var list = []struct {
+		field string
+		words string
+		strip string
+	}{
+		{"", "", ""},
+		{"Hello world!", "hello-world", "hello-world"},
+		{"I ❤ Go 🚀", "i-red-heart-go-rocket", "i-go"},
+		{"I ❤️ Go", "i-red-heart-go", "i-go"},
+		{"Go🚀Fast", "go-rocket-fast", "gofast"},
+		{"👍🏽 thanks", "thumbs-up-thanks", "thanks"},                 // skin tone modifier
+		{"👩🏿‍💻 at work", "woman-technologist-at-work", "at-work"},   // ZWJ sequence with a skin tone
+		{"❤️‍🔥", "heart-on-fire", ""},                               // ZWJ sequence in the table
+		{"🚀‍🐈", "rocket-cat", ""},                                   // ZWJ sequence missing from the table
+		{"🇺🇸 and 🇩🇪", "flag-united-states-and-flag-germany", "and"}, // regional indicators
+		{"🏴󠁧󠁢󠁳󠁣󠁴󠁿", "flag-scotland", ""},                            // tag sequence
+		{"Room 1️⃣", "room-keycap-1", "room"},                       // keycap
+		{"Room 1", "room-1", "room-1"},                              // a plain digit is not a keycap
+		{"© 2017 Acme™", "c-2017-acme", "c-2017-acme"},              // text symbols are transliterated
+		{"©️ 2017", "copyright-2017", "2017"},                       // unless they ask for emoji presentation
+		{"stray \U0001F3FB\u200d\ufe0f modifiers", "stray-modifiers", "stray-modifiers"},
+		{"Gültige 😀 Test", "gultige-grinning-face-test", "gultige-test"},
+	}
+
+	for _, l := range list {
+		if result := (Slugger{Emoji: EmojiWords}).Slug(l.field); result != l.words {
+			t.Errorf("Slugger{EmojiWords}.Slug(%q): Result[%s]. Expected: %s", l.field, result, l.words)
+		}
+
+		if result := (Slugger{Emoji: EmojiStrip}).Slug(l.field); result != l.strip {
+			t.Errorf("Slugger{EmojiStrip}.Slug(%q): Result[%s]. Expected: %s", l.field, result, l.strip)
+		}
+
+		// the zero value is the same as GetAsciiSlug
+		if result, expectation := (Slugger{}).Slug(l.field), GetAsciiSlug(l.field); result != expectation {
+			t.Errorf("Slugger{}.Slug(%q): Result[%s]. Expected: %s", l.field, result, expectation)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package slug
+
+import "strings"
+
+// limit of most filesystems (ext4, NTFS, APFS) for a single file name
+const MaxFilenameBytes = 255
+
+// names that Windows reserves for devices, with or without an extension
+var windowsReservedNames = map[string]bool{
+	"con": true, "prn": true, "aux": true, "nul": true,
+	"com1": true, "com2": true, "com3": true, "com4": true, "com5": true, "com6": true, "com7": true, "com8": true, "com9": true,
+	"lpt1": true, "lpt2": true, "lpt3": true, "lpt4": true, "lpt5": true, "lpt6": true, "lpt7": true, "lpt8": true, "lpt9": true,
+}
+
+func GetFilenameSlug(name string) string {
+	return Slugger{}.Filename(name)
+}
+
+/*
+	Slugs a file name and keeps its final extension (Ex: "Report.Final.PDF" -> "report-final.pdf").
+	Windows device names get a suffix ("CON.txt" -> "con-file.txt") and the result is cut to FilenameMaxBytes, at a hypthen if possible.
+	A name without letters or numbers before the extension is blank, so "." and ".." are never returned.
+*/
+func (s Slugger) Filename(name string) string {
+	max := s.FilenameMaxBytes
+	if max <= 0 {
+		max = MaxFilenameBytes
+	}
+
+	var ext string
+	if i := strings.LastIndex(name, "."); i > 0 {
+		ext = strings.Replace(GetAsciiSlug(name[i+1:]), "-", "", -1)
+		if ext != "" {
+			name = name[:i]
+		}
+	}
+
+	base := s.Slug(name)
+	if base == "" {
+		return ""
+	}
+
+	if windowsReservedNames[base] {
+		base += "-file"
+	}
+
+	// the extension keeps at least one character of the name and the dot, it is dropped when the limit is too small for both
+	if max < 3 {
+		ext = ""
+	} else if len(ext) > max-2 {
+		ext = ext[:max-2]
+	}
+
+	limit := max
+	if ext != "" {
+		limit -= len(ext) + 1
+	}
+
+	if len(base) > limit {
+		// the cut is moved back to the last hypthen unless it already falls on one
+		cut := base[limit] == '-'
+		base = base[:limit]
+		if i := strings.LastIndex(base, "-"); !cut && i > 0 {
+			base = base[:i]
+		}
+
+		// kept dots of numbers can end the cut (Ex: "1.21.3" -> "1."), a trailing dot is not valid on Windows
+		base = strings.TrimRight(base, "-.")
+
+		// the cut can leave a device name (Ex: "con-file" -> "con")
+		if windowsReservedNames[base] {
+			base = base[:len(base)-1]
+		}
+	}
+
+	if ext == "" {
+		return base
+	}
+
+	return base + "." + ext
+}
@@ -0,0 +1,84 @@
+package slug
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGetFilenameSlug(t *testing.T) {
+	var list = []stringStruct{
+		{"", ""},
+		{".", ""},
+		{"..", ""},
+		{"...", ""},
+		{".pdf", "pdf"}, // a hidden file, the dot is not an extension
+		{"~!@.pdf", ""},
+		{"report.pdf", "report.pdf"},
+		{"report.final.pdf", "report-final.pdf"},
+		{"Report.Final.PDF", "report-final.pdf"},
+		{"My Vacation Photo (1).JPEG", "my-vacation-photo-1.jpeg"},
+		{"archive.tar.gz", "archive-tar.gz"},
+		{"no extension", "no-extension"},
+		{"trailing dot.", "trailing-dot"},
+		{"symbols.~!@", "symbols"},
+		{".bashrc", "bashrc"},
+		{"Gültige Datei.txt", "gultige-datei.txt"},
+		{"中文.doc", "zhong-wen.doc"},
+		{"CON", "con-file"},
+		{"con.txt", "con-file.txt"},
+		{"Nul.tar.gz", "nul-tar.gz"},
+		{"COM1.log", "com1-file.log"},
+		{"com10.log", "com10.log"},
+		{"lpt9", "lpt9-file"},
+	}
+
+	for _, l := range list {
+		if result := GetFilenameSlug(l.field); result != l.expectation {
+			t.Errorf("GetFilenameSlug(%v): Result[%s]. Expected: %s", l.field, result, l.expectation)
+		}
+	}
+}
+
+func TestFilenameMaxBytes(t *testing.T) {
+	var list = []struct {
+		field       string
+		max         int
+		expectation string
+	}{
+		{"hello world.txt", 11, "hello.txt"},
+		{"hello world.txt", 15, "hello-world.txt"},
+		{"helloworld.txt", 11, "hellowo.txt"},
+		{"a.verylongextension", 6, "a.very"},
+		{"hello-world", 8, "hello"},
+		{"hello--world.md", 9, "hello.md"},
+		{"a.txt", 1, "a"},
+		{"hello.txt", 1, "h"},
+		{"hello.txt", 2, "he"},
+		{"hello.txt", 3, "h.t"},
+		{"hello", 1, "h"},
+		{"CON.txt", 7, "co.txt"},
+		{"con", 3, "co"},
+		{"hello world foo", 11, "hello-world"},
+		{"hello world foo.txt", 15, "hello-world.txt"},
+	}
+
+	for _, l := range list {
+		if result := (Slugger{FilenameMaxBytes: l.max}).Filename(l.field); result != l.expectation {
+			t.Errorf("Filename(%v, %d): Result[%s]. Expected: %s", l.field, l.max, result, l.expectation)
+		}
+	}
+
+	// the cut does not leave a kept dot before the extension
+	versions := Slugger{Versions: NumberKeepDots}
+	for max, expectation := range map[int]string{6: "1.txt", 8: "1.21.txt", 10: "1.21.3.txt"} {
+		versions.FilenameMaxBytes = max
+		if result := versions.Filename("1.21.3.txt"); result != expectation {
+			t.Errorf("Slugger{Versions: NumberKeepDots}.Filename(1.21.3.txt, %d): Result[%s]. Expected: %s", max, result, expectation)
+		}
+	}
+
+	long := GetFilenameSlug(strings.Repeat("word ", 100) + ".pdf")
+	if len(long) > MaxFilenameBytes || !strings.HasSuffix(long, "-word.pdf") {
+		t.Errorf("GetFilenameSlug(): Result[%s] %d bytes. Expected at most %d", long, len(long), MaxFilenameBytes)
+	}
+}
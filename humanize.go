@@ -0,0 +1,91 @@
+package slug
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// acronyms and names written as given by Humanize, they are matched without case
+var DefaultAcronyms = []string{
+	"API", "CSS", "CSV", "DNS", "FAQ", "Go", "GPS", "HTML", "HTTP", "HTTPS", "ID", "IP", "JSON", "PDF", "PHP",
+	"RSS", "SDK", "SEO", "SQL", "SSH", "SSL", "TCP", "TLS", "UDP", "UI", "URL", "USB", "UX", "XML",
+}
+
+// words that are not capitalized by title case unless they are the first or last word, see byLanguage
+var smallWords = map[string]map[string]bool{
+	"en": {"a": true, "an": true, "and": true, "as": true, "at": true, "but": true, "by": true, "for": true, "in": true,
+		"nor": true, "of": true, "on": true, "or": true, "per": true, "the": true, "to": true, "vs": true, "via": true},
+	"de": {"der": true, "die": true, "das": true, "den": true, "dem": true, "des": true, "ein": true, "eine": true,
+		"und": true, "oder": true, "im": true, "in": true, "am": true, "an": true, "auf": true, "von": true, "zu": true, "mit": true},
+	"fr": {"le": true, "la": true, "les": true, "l": true, "un": true, "une": true, "de": true, "des": true, "du": true, "d": true,
+		"et": true, "ou": true, "a": true, "au": true, "aux": true, "en": true, "sur": true, "pour": true, "par": true, "dans": true},
+	"es": {"el": true, "la": true, "los": true, "las": true, "un": true, "una": true, "de": true, "del": true,
+		"y": true, "e": true, "o": true, "u": true, "a": true, "al": true, "en": true, "por": true, "para": true, "con": true},
+	"it": {"il": true, "lo": true, "la": true, "i": true, "gli": true, "le": true, "un": true, "una": true, "di": true, "del": true,
+		"della": true, "e": true, "o": true, "a": true, "al": true, "in": true, "per": true, "con": true, "su": true, "da": true},
+	"pt": {"o": true, "a": true, "os": true, "as": true, "um": true, "uma": true, "de": true, "do": true, "da": true, "dos": true,
+		"das": true, "e": true, "ou": true, "em": true, "no": true, "na": true, "por": true, "para": true, "com": true},
+	"nl": {"de": true, "het": true, "een": true, "en": true, "of": true, "in": true, "op": true, "van": true, "te": true,
+		"met": true, "voor": true, "aan": true},
+}
+
+/*
+	Options for HumanizeWith, the zero value is the same as Humanize.
+
+	With TitleCase every word is capitalized except the small words of Language (Ex: "of", "the"), which are only capitalized
+	as the first or last word. Language also selects the case mapping rules (Ex: Turkish "istanbul" -> "İstanbul").
+
+	Acronyms are written as given wherever they are (Ex: "go-api" -> "Go API"), nil is DefaultAcronyms (append to it to extend the list).
+*/
+type HumanizeOptions struct {
+	TitleCase bool
+	Language  language.Tag
+	Acronyms  []string
+}
+
+// "hello-world-an-introduction-to-golang" -> "Hello world an introduction to golang"
+func Humanize(sl string) string {
+	return HumanizeWith(sl, HumanizeOptions{})
+}
+
+/*
+	Turns a slug back into a readable title, for slugs without their original title (Ex: imported URLs).
+	Hypthens and underscores become spaces, the first word is capitalized and the other words are kept as they are.
+*/
+func HumanizeWith(sl string, opt HumanizeOptions) string {
+	words := strings.FieldsFunc(sl, func(c rune) bool { return c == '-' || c == '_' || c == ' ' })
+	if len(words) == 0 {
+		return ""
+	}
+
+	list := opt.Acronyms
+	if list == nil {
+		list = DefaultAcronyms
+	}
+
+	acronyms := make(map[string]string, len(list))
+	for _, word := range list {
+		acronyms[strings.ToLower(word)] = word
+	}
+
+	small := byLanguage(smallWords, opt.Language)
+	title := cases.Title(opt.Language, cases.NoLower)
+
+	for i, word := range words {
+		lower := strings.ToLower(word)
+
+		switch {
+		case acronyms[lower] != "":
+			words[i] = acronyms[lower]
+		case !unicode.IsLetter([]rune(word)[0]):
+			// cases.Title would capitalize the letter after the digits (Ex: "2Nd")
+		case i == 0, opt.TitleCase && (i == len(words)-1 || !small[lower]):
+			words[i] = title.String(word)
+		}
+	}
+
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,75 @@
+package slug
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestHumanize(t *testing.T) {
+	var list = []stringStruct{
+		{"", ""},
+		{"-", ""},
+		{"hello-world-an-introduction-to-golang", "Hello world an introduction to golang"},
+		{"hello", "Hello"},
+		{"rest-api-design", "Rest API design"},
+		{"json-to-csv", "JSON to CSV"},
+		{"2nd-try", "2nd try"},
+		{"snake_case_slug", "Snake case slug"},
+		{"über-uns", "Über uns"},
+	}
+
+	for _, l := range list {
+		if result := Humanize(l.field); result != l.expectation {
+			t.Errorf("Humanize(%v): Result[%s]. Expected: %s", l.field, result, l.expectation)
+		}
+	}
+}
+
+func TestHumanizeTitleCase(t *testing.T) {
+	var list = []struct {
+		field       string
+		lang        language.Tag
+		expectation string
+	}{
+		{"hello-world-an-introduction-to-golang", language.Und, "Hello World an Introduction to Golang"},
+		{"the-lord-of-the-rings", language.English, "The Lord of the Rings"},
+		{"what-are-you-looking-for", language.English, "What Are You Looking For"},
+		{"le-petit-prince-de-la-ville", language.French, "Le Petit Prince de la Ville"},
+		{"cien-anos-de-soledad", language.Spanish, "Cien Anos de Soledad"},
+		{"het-huis-van-de-vader", language.Dutch, "Het Huis van de Vader"},
+		{"istanbul-ve-izmir", language.Turkish, "İstanbul Ve İzmir"},
+	}
+
+	for _, l := range list {
+		opt := HumanizeOptions{TitleCase: true, Language: l.lang}
+		if result := HumanizeWith(l.field, opt); result != l.expectation {
+			t.Errorf("HumanizeWith(%v, %v): Result[%s]. Expected: %s", l.field, l.lang, result, l.expectation)
+		}
+	}
+}
+
+func TestHumanizeAcronyms(t *testing.T) {
+	opt := HumanizeOptions{Acronyms: append(DefaultAcronyms, "iOS")}
+
+	var list = []stringStruct{
+		{"go-api-client", "Go API client"},
+		{"building-ios-apps-in-go", "Building iOS apps in Go"},
+		{"html-and-css", "HTML and CSS"},
+	}
+
+	for _, l := range list {
+		if result := HumanizeWith(l.field, opt); result != l.expectation {
+			t.Errorf("HumanizeWith(%v): Result[%s]. Expected: %s", l.field, result, l.expectation)
+		}
+	}
+
+	if result := Humanize("learning-go"); result != "Learning Go" {
+		t.Errorf("Humanize(learning-go): Result[%s]. Expected: Learning Go", result)
+	}
+
+	// an empty list turns the acronyms off
+	if result := HumanizeWith("api-docs", HumanizeOptions{Acronyms: []string{}}); result != "Api docs" {
+		t.Errorf("HumanizeWith(api-docs): Result[%s]. Expected: Api docs", result)
+	}
+}
@@ -0,0 +1,97 @@
+package slug
+
+import "strings"
+
+// Go keywords, an identifier cannot be one of them
+var goKeywords = map[string]bool{
+	"break": true, "case": true, "chan": true, "const": true, "continue": true, "default": true, "defer": true,
+	"else": true, "fallthrough": true, "for": true, "func": true, "go": true, "goto": true, "if": true,
+	"import": true, "interface": true, "map": true, "package": true, "range": true, "return": true,
+	"select": true, "struct": true, "switch": true, "type": true, "var": true,
+}
+
+func SnakeCase(title string) string    { return Slugger{}.SnakeCase(title) }
+func CamelCase(title string) string    { return Slugger{}.CamelCase(title) }
+func PascalCase(title string) string   { return Slugger{}.PascalCase(title) }
+func ConstantCase(title string) string { return Slugger{}.ConstantCase(title) }
+func KebabCase(title string) string    { return Slugger{}.KebabCase(title) }
+
+// "Hello world! 2nd try" -> "hello_world_2nd_try"
+func (s Slugger) SnakeCase(title string) string {
+	return s.identifier(strings.Replace(s.Slug(title), "-", "_", -1))
+}
+
+// "Hello world! 2nd try" -> "helloWorld2ndTry"
+func (s Slugger) CamelCase(title string) string {
+	words := s.words(title)
+
+	for i := 1; i < len(words); i++ {
+		words[i] = upperFirst(words[i])
+	}
+
+	return s.identifier(strings.Join(words, ""))
+}
+
+// "Hello world! 2nd try" -> "HelloWorld2ndTry"
+func (s Slugger) PascalCase(title string) string {
+	words := s.words(title)
+
+	for i := range words {
+		words[i] = upperFirst(words[i])
+	}
+
+	return s.identifier(strings.Join(words, ""))
+}
+
+// "Hello world! 2nd try" -> "HELLO_WORLD_2ND_TRY"
+func (s Slugger) ConstantCase(title string) string {
+	return s.identifier(strings.ToUpper(strings.Replace(s.Slug(title), "-", "_", -1)))
+}
+
+// "Hello world! 2nd try" -> "hello-world-2nd-try", the same as Slug, hypthens are not allowed in identifiers so there are no prefixes or keyword checks
+func (s Slugger) KebabCase(title string) string {
+	return s.Slug(title)
+}
+
+// the words of the slug, split on the same separators as GetAsciiSlug
+func (s Slugger) words(title string) []string {
+	sl := s.Slug(title)
+	if sl == "" {
+		return nil
+	}
+
+	return strings.Split(sl, "-")
+}
+
+// the words are ascii, so the first byte is the first letter
+func upperFirst(word string) string {
+	if word == "" || word[0] < 'a' || word[0] > 'z' {
+		return word
+	}
+
+	return string(word[0]-'a'+'A') + word[1:]
+}
+
+// identifiers cannot start with a digit, and with AvoidKeywords they cannot be a Go keyword
+func (s Slugger) identifier(id string) string {
+	if id == "" {
+		return id
+	}
+
+	// dots kept by NumberKeepDots (Ex: "go_1.21.3" -> "go_1_21_3")
+	id = strings.Replace(id, ".", "_", -1)
+
+	if id[0] >= '0' && id[0] <= '9' {
+		prefix := s.DigitPrefix
+		if prefix == "" {
+			prefix = "_"
+		}
+		id = prefix + id
+	}
+
+	if s.AvoidKeywords && goKeywords[id] {
+		id += "_"
+	}
+
+	return id
+}
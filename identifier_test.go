@@ -0,0 +1,62 @@
+package slug
+
+import "testing"
+
+func TestIdentifierCases(t *testing.T) {
+	var list = []struct {
+		field    string
+		snake    string
+		camel    string
+		pascal   string
+		constant string
+		kebab    string
+	}{
+		{"", "", "", "", "", ""},
+		{"~!@#", "", "", "", "", ""},
+		{"Hello world! 2nd try", "hello_world_2nd_try", "helloWorld2ndTry", "HelloWorld2ndTry", "HELLO_WORLD_2ND_TRY", "hello-world-2nd-try"},
+		{"user_id", "user_id", "userId", "UserId", "USER_ID", "user-id"},
+		{"  first.name  ", "first_name", "firstName", "FirstName", "FIRST_NAME", "first-name"},
+		{"Größe der Datei", "grosse_der_datei", "grosseDerDatei", "GrosseDerDatei", "GROSSE_DER_DATEI", "grosse-der-datei"},
+		{"1st place", "_1st_place", "_1stPlace", "_1stPlace", "_1ST_PLACE", "1st-place"},
+		{"2 + 2", "_2_2", "_22", "_22", "_2_2", "2-2"},
+		{"type", "type", "type", "Type", "TYPE", "type"},
+	}
+
+	for _, l := range list {
+		if result := SnakeCase(l.field); result != l.snake {
+			t.Errorf("SnakeCase(%v): Result[%s]. Expected: %s", l.field, result, l.snake)
+		}
+		if result := CamelCase(l.field); result != l.camel {
+			t.Errorf("CamelCase(%v): Result[%s]. Expected: %s", l.field, result, l.camel)
+		}
+		if result := PascalCase(l.field); result != l.pascal {
+			t.Errorf("PascalCase(%v): Result[%s]. Expected: %s", l.field, result, l.pascal)
+		}
+		if result := ConstantCase(l.field); result != l.constant {
+			t.Errorf("ConstantCase(%v): Result[%s]. Expected: %s", l.field, result, l.constant)
+		}
+		if result := KebabCase(l.field); result != l.kebab {
+			t.Errorf("KebabCase(%v): Result[%s]. Expected: %s", l.field, result, l.kebab)
+		}
+	}
+}
+
+func TestIdentifierOptions(t *testing.T) {
+	s := Slugger{DigitPrefix: "N", AvoidKeywords: true}
+
+	var list = []stringStruct{
+		{s.SnakeCase("1st place"), "N1st_place"},
+		{s.PascalCase("3D model"), "N3dModel"},
+		{s.SnakeCase("type"), "type_"},
+		{s.CamelCase("Go"), "go_"},
+		{s.CamelCase("Go routine"), "goRoutine"},
+		{s.PascalCase("type"), "Type"},
+		{s.ConstantCase("func"), "FUNC"},
+	}
+
+	for _, l := range list {
+		if l.field != l.expectation {
+			t.Errorf("Slugger{DigitPrefix, AvoidKeywords}: Result[%s]. Expected: %s", l.field, l.expectation)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package slug
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// invisible characters that break words, they become separators
+var invisibleSeparators = map[rune]string{
+	'\u200b': "zero width space",
+	'\u2028': "line separator",
+	'\u2029': "paragraph separator",
+}
+
+// invisible format, filler and bidi control characters, they are removed
+var invisibleNames = map[rune]string{
+	'\u00ad': "soft hyphen",
+	'\u034f': "combining grapheme joiner",
+	'\u061c': "arabic letter mark",
+	'\u115f': "hangul choseong filler",
+	'\u1160': "hangul jungseong filler",
+	'\u17b4': "khmer vowel inherent aq",
+	'\u17b5': "khmer vowel inherent aa",
+	'\u180e': "mongolian vowel separator",
+	'\u200c': "zero width non-joiner",
+	'\u200d': "zero width joiner",
+	'\u200e': "left-to-right mark",
+	'\u200f': "right-to-left mark",
+	'\u202a': "left-to-right embedding",
+	'\u202b': "right-to-left embedding",
+	'\u202c': "pop directional formatting",
+	'\u202d': "left-to-right override",
+	'\u202e': "right-to-left override",
+	'\u2060': "word joiner",
+	'\u2061': "function application",
+	'\u2062': "invisible times",
+	'\u2063': "invisible separator",
+	'\u2064': "invisible plus",
+	'\u2066': "left-to-right isolate",
+	'\u2067': "right-to-left isolate",
+	'\u2068': "first strong isolate",
+	'\u2069': "pop directional isolate",
+	'\u3164': "hangul filler",
+	'\ufeff': "byte order mark",
+	'\uffa0': "halfwidth hangul filler",
+}
+
+// the name of an invisible character, and whether it separates words
+func invisibleChar(c rune) (string, bool, bool) {
+	if name, found := invisibleSeparators[c]; found {
+		return name, true, true
+	}
+
+	if name, found := invisibleNames[c]; found {
+		return name, false, true
+	}
+
+	switch {
+	case c >= 0x180b && c <= 0x180f:
+		return "mongolian free variation selector", false, true
+	case c >= 0x206a && c <= 0x206f:
+		return "deprecated format character", false, true
+	case c >= 0xfe00 && c <= 0xfe0f, c >= 0xe0100 && c <= 0xe01ef:
+		return "variation selector", false, true
+	case c >= 0x1d173 && c <= 0x1d17a:
+		return "musical symbol format character", false, true
+	case c == 0xe0001, c >= 0xe0020 && c <= 0xe007f:
+		return "tag character", false, true
+	}
+
+	return "", false, false
+}
+
+// zero width spaces and line separators become spaces, other invisible characters are removed
+func replaceInvisible(title string) string {
+	if isAsciiString(title) {
+		return title
+	}
+
+	var b strings.Builder
+	b.Grow(len(title))
+
+	for _, c := range title {
+		if _, separator, invisible := invisibleChar(c); !invisible {
+			b.WriteRune(c)
+		} else if separator {
+			b.WriteByte(' ')
+		}
+	}
+
+	return b.String()
+}
+
+// a slug or tag rejected because it contains an invisible or bidi control character
+type InvisibleCharError struct {
+	Char   rune
+	Name   string
+	Offset int // in bytes
+}
+
+func (e *InvisibleCharError) Error() string {
+	return fmt.Sprintf("This field contains an invisible character (%U %s) at position %d.", e.Char, e.Name, e.Offset)
+}
+
+var ErrMixedScript = errors.New("This field mixes letters of different scripts.")
+
+func findInvisible(s string) error {
+	for i, c := range s {
+		if name, _, invisible := invisibleChar(c); invisible {
+			return &InvisibleCharError{Char: c, Name: name, Offset: i}
+		}
+	}
+	return nil
+}
+
+/*
+	Same as IsUTF8SlugWith, with the reason a slug is rejected: an *InvisibleCharError, ErrInvalidSlug or ErrMixedScript.
+	Invisible characters are found before normalization, so the offset is in the original slug.
+*/
+func CheckUTF8Slug(sl string, opt UTF8Options) error {
+	if err := findInvisible(sl); err != nil {
+		return err
+	}
+
+	sl = NormalizeUTF8(sl, opt)
+	if temp, ok := utf8Shape(sl, '-', opt); !ok || !isSlugValid.MatchString(temp) {
+		return ErrInvalidSlug
+	}
+
+	if opt.SingleScript && IsMixedScript(sl) {
+		return ErrMixedScript
+	}
+
+	return nil
+}
+
+// same as CheckUTF8Slug for tags, see IsUTF8ItemTagWith
+func CheckUTF8ItemTag(tag string, opt UTF8Options) error {
+	if err := findInvisible(tag); err != nil {
+		return err
+	}
+
+	tag = NormalizeUTF8(tag, opt)
+	if temp, ok := utf8Shape(tag, ' ', opt); !ok || !isTagValid.MatchString(temp) {
+		return ErrInvalidSlug
+	}
+
+	if opt.SingleScript && IsMixedScript(tag) {
+		return ErrMixedScript
+	}
+
+	return nil
+}
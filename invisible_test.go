@@ -0,0 +1,79 @@
+package slug
+
+import "testing"
+
+func TestSluggerInvisible(t *testing.T) {
+	var list = []struct {
+		field     string
+		plain     string
+		invisible string
+	}{
+		{"hello\u200bworld", "hello-world", "hello-world"},  // zero width space
+		{"hyphen\u00adation", "hyphenation", "hyphenation"}, // soft hyphen
+		{"\ufeffhello", "hello", "hello"},                   // byte order mark
+		{"word\u2060joiner", "word-joiner", "wordjoiner"},   // the transliteration splits on it
+		{"left\u2066to\u2069right", "left-to-right", "lefttoright"},
+		{"evil\u202egnp.exe", "evilgnp-exe", "evilgnp-exe"},
+		{"a\u17b4b", "aab", "ab"}, // transliterated as a letter
+		{"line\u2028break", "line-break", "line-break"},
+		{"zwnj\u200cword", "zwnjword", "zwnjword"},
+	}
+
+	for _, l := range list {
+		if result := (Slugger{}).Slug(l.field); result != l.plain {
+			t.Errorf("Slugger{}.Slug(%q): Result[%s]. Expected: %s", l.field, result, l.plain)
+		}
+		if result := (Slugger{Invisible: true}).Slug(l.field); result != l.invisible {
+			t.Errorf("Slugger{Invisible}.Slug(%q): Result[%s]. Expected: %s", l.field, result, l.invisible)
+		}
+	}
+
+	// emoji sequences are read before invisible characters are removed
+	if result := (Slugger{Invisible: true, Emoji: EmojiWords}).Slug("👩\u200d💻 work"); result != "woman-technologist-work" {
+		t.Errorf("Slugger{Invisible, EmojiWords}.Slug(): Result[%s]. Expected: woman-technologist-work", result)
+	}
+}
+
+func TestCheckUTF8Slug(t *testing.T) {
+	var list = []struct {
+		field  string
+		char   rune
+		offset int
+	}{
+		{"hello\u200bworld", '\u200b', 5},
+		{"\ufeffпривет", '\ufeff', 0},
+		{"مرحبا-\u200fعالم", '\u200f', 11},
+		{"pay\u202epal", '\u202e', 3},
+		{"soft\u00adhyphen", '\u00ad', 4},
+	}
+
+	for _, l := range list {
+		for _, err := range []error{CheckUTF8Slug(l.field, UTF8Options{}), CheckUTF8ItemTag(l.field, UTF8Options{Normalize: true})} {
+			e, ok := err.(*InvisibleCharError)
+			if !ok {
+				t.Errorf("CheckUTF8Slug(%q): Result[%v]. Expected an *InvisibleCharError", l.field, err)
+				continue
+			}
+			if e.Char != l.char || e.Offset != l.offset || e.Name == "" {
+				t.Errorf("CheckUTF8Slug(%q): Result[%U %d %s]. Expected: %U %d", l.field, e.Char, e.Offset, e.Name, l.char, l.offset)
+			}
+		}
+
+		if IsUTF8Slug(l.field) || IsUTF8ItemTag(l.field) {
+			t.Errorf("IsUTF8Slug(%q): Valid[true]. Expected: false", l.field)
+		}
+	}
+
+	if err := CheckUTF8Slug("hello-world", UTF8Options{}); err != nil {
+		t.Errorf("CheckUTF8Slug(hello-world): Result[%v]. Expected: <nil>", err)
+	}
+	if err := CheckUTF8Slug("hello world", UTF8Options{}); err != ErrInvalidSlug {
+		t.Errorf("CheckUTF8Slug(hello world): Result[%v]. Expected: %v", err, ErrInvalidSlug)
+	}
+	if err := CheckUTF8Slug("pаypal", UTF8Options{SingleScript: true}); err != ErrMixedScript {
+		t.Errorf("CheckUTF8Slug(pаypal): Result[%v]. Expected: %v", err, ErrMixedScript)
+	}
+	if err := CheckUTF8ItemTag("hello world", UTF8Options{}); err != nil {
+		t.Errorf("CheckUTF8ItemTag(hello world): Result[%v]. Expected: <nil>", err)
+	}
+}
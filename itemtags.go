@@ -74,6 +74,88 @@ func GetTagsAndTagSlugs(tags string) ([]string, []string) {
 	return tagList, slugList
 }
 
+// what happened to a tag of a list
+type TagAction int
+
+const (
+	TagKept    TagAction = iota
+	TagDropped           // nothing was left after slugging
+	TagMerged            // the slug is the same as the slug of an earlier tag
+)
+
+func (a TagAction) String() string {
+	switch a {
+	case TagDropped:
+		return "dropped"
+	case TagMerged:
+		return "merged"
+	}
+	return "kept"
+}
+
+// a tag of a list, with its slug and what happened to it
+type TagEntry struct {
+	Tag    string
+	Slug   string
+	Action TagAction
+}
+
+// every tag of a comma separated list with its action, blank entries are skipped, see Slugger.ParseTags
+func ParseTags(tags string) []TagEntry {
+	return Slugger{}.ParseTags(tags)
+}
+
+/*
+	The tags of a comma separated list in order, with the action GetTagsAndTagSlugs takes on each of them, blank entries are skipped.
+	Reserved words do not apply to tags. A tag changed by a blocked word is displayed as its slug with spaces, so the word is not shown.
+*/
+func (s Slugger) ParseTags(tags string) []TagEntry {
+	var list []TagEntry
+	encounteredItems := make(map[string]bool)
+
+	s.Reserved = nil
+	plain := s
+	plain.Blocked = nil
+
+	for _, tag := range strings.Split(tags, DELIMITER) {
+		if len(tag) == 0 {
+			continue
+		}
+
+		sl := s.Slug(tag)
+
+		if s.Blocked != nil && sl != "" && sl != plain.Slug(tag) {
+			tag = strings.Replace(sl, "-", " ", -1)
+		}
+
+		switch {
+		case len(sl) == 0:
+			list = append(list, TagEntry{tag, "", TagDropped})
+		case encounteredItems[sl]:
+			list = append(list, TagEntry{tag, sl, TagMerged})
+		default:
+			encounteredItems[sl] = true
+			list = append(list, TagEntry{tag, sl, TagKept})
+		}
+	}
+
+	return list
+}
+
+// same as GetTagsAndTagSlugs with the options of the Slugger, see Slugger.ParseTags
+func (s Slugger) GetTagsAndTagSlugs(tags string) ([]string, []string) {
+	var tagList, slugList []string
+
+	for _, entry := range s.ParseTags(tags) {
+		if entry.Action == TagKept {
+			tagList = append(tagList, entry.Tag)
+			slugList = append(slugList, entry.Slug)
+		}
+	}
+
+	return tagList, slugList
+}
+
 func IsItemTag(tag string) bool {
 	return isTagValid.MatchString(tag)
 }
@@ -554,3 +554,46 @@ func TestIsUTF8ItemTagList(t *testing.T) {
 		}
 	}
 }
+
+func TestParseTags(t *testing.T) {
+	entries := ParseTags("Go,,golang, GO ,~!@,Go")
+
+	expected := []TagEntry{
+		{"Go", "go", TagKept},
+		{"golang", "golang", TagKept},
+		{" GO ", "go", TagMerged},
+		{"~!@", "", TagDropped},
+		{"Go", "go", TagMerged},
+	}
+
+	if len(entries) != len(expected) {
+		t.Fatalf("ParseTags(): Result[%v]. Expected: %v", entries, expected)
+	}
+	for i := range expected {
+		if entries[i] != expected[i] {
+			t.Errorf("ParseTags()[%d]: Result[%v]. Expected: %v", i, entries[i], expected[i])
+		}
+	}
+
+	// the kept tags are the ones GetTagsAndTagSlugs returns
+	for _, field := range []string{
+		"",
+		",,,,,,,,O@nE,,,,,,,TWo!#,tHreE$ ",
+		",,,,,,,,     O@nE    ,,,,,     O@nE    ,,,,  T  W  o  !#,,     O@nE    ,tHr    eE$ ",
+		"@#$%^&*(,abc@#$%^&*(def, One in the middle too., abc@#$%^&*(def, abc def, ABc dEf, Another post here!,What about this?",
+		"-,--, - ,a-b,a b",
+	} {
+		var tagList, slugList []string
+		for _, entry := range ParseTags(field) {
+			if entry.Action == TagKept {
+				tagList = append(tagList, entry.Tag)
+				slugList = append(slugList, entry.Slug)
+			}
+		}
+
+		expectedTags, expectedSlugs := GetTagsAndTagSlugs(field)
+		if !sliceEqual(tagList, expectedTags) || !sliceEqual(slugList, expectedSlugs) {
+			t.Errorf("ParseTags(%v): Result[%v %v]. Expected: %v %v", field, tagList, slugList, expectedTags, expectedSlugs)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package slug
+
+import (
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm" // external dependency
+)
+
+/*
+	Options for IsUTF8SlugWith and IsUTF8ItemTagWith, the zero value is the same as IsUTF8Slug and IsUTF8ItemTag.
+
+	With Normalize the input is normalized with Form first, so a decomposed "é" (e + U+0301) is the same as "é".
+	norm.NFKC also folds compatibility characters, full-width "ＡＢＣ１" becomes "ABC1".
+	Combining marks that remain after a letter are allowed (Ex: Devanagari vowel signs), they cannot start a word.
+
+	With SingleScript a slug or tag mixing letters of different scripts is rejected, see IsMixedScript.
+	With Digits the decimal digits of every script are numbers (Ex: Arabic-Indic "٣"), otherwise only ASCII digits are.
+
+	With Lowercase the case mapping rules of Language are used, Turkish and Azeri "İSTANBUL" -> "istanbul" and "I" -> "ı",
+	Lithuanian keeps the dot of "i" under accents. language.Und uses the default Unicode rules, like strings.ToLower.
+*/
+type UTF8Options struct {
+	Normalize bool
+	Form      norm.Form // norm.NFC (the zero value) or norm.NFKC
+
+	SingleScript bool
+	Digits       bool
+
+	Lowercase bool
+	Language  language.Tag
+}
+
+// the string the validators check, use it to store slugs and tags so equal looking values are equal
+func NormalizeUTF8(s string, opt UTF8Options) string {
+	if opt.Normalize {
+		s = opt.Form.String(s)
+	}
+
+	if opt.Lowercase {
+		// a Caser keeps state, so one is made for every call
+		s = cases.Lower(opt.Language).String(s)
+
+		if opt.Normalize {
+			s = opt.Form.String(s)
+		}
+	}
+
+	return s
+}
+
+// see CheckUTF8Slug for the reason a slug is rejected
+func IsUTF8SlugWith(sl string, opt UTF8Options) bool {
+	return CheckUTF8Slug(sl, opt) == nil
+}
+
+// see CheckUTF8ItemTag for the reason a tag is rejected
+func IsUTF8ItemTagWith(tag string, opt UTF8Options) bool {
+	return CheckUTF8ItemTag(tag, opt) == nil
+}
+
+/*
+	Replaces every letter and number with "a" and keeps the separator, so the ascii regexps can check the shape of the words.
+	False when another character is found.
+*/
+func utf8Shape(s string, sep rune, opt UTF8Options) (string, bool) {
+	temp := make([]byte, 0, len(s))
+
+	for _, c := range s {
+		switch {
+		case unicode.IsLetter(c) || isDigit(c) || (opt.Digits && unicode.Is(unicode.Nd, c)):
+			temp = append(temp, 'a')
+		case c == sep:
+			temp = append(temp, byte(sep))
+		case opt.Normalize && unicode.Is(unicode.M, c) && len(temp) > 0 && temp[len(temp)-1] == 'a':
+			// a combining mark belongs to the letter before it
+		default:
+			return "", false
+		}
+	}
+
+	return string(temp), true
+}
@@ -0,0 +1,150 @@
+package slug
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestIsUTF8SlugWith(t *testing.T) {
+	var list = []struct {
+		field string
+		plain bool
+		nfc   bool
+		nfkc  bool
+	}{
+		{"", false, false, false},
+		{"café", true, true, true},
+		{"cafe\u0301", false, true, true}, // decomposed é
+		{"cafe\u0301-au-lait", false, true, true},
+		{"\u0301cafe", false, false, false}, // a mark cannot start a word
+		{"cafe-\u0301au", false, false, false},
+		{"ＡＢＣ", true, true, true},       // full-width letters are letters
+		{"ＡＢＣ１", false, false, true},    // full-width digits are only folded by NFKC
+		{"ＡＢＣ－ＤＥＦ", false, false, true}, // as is the full-width hyphen
+		{"हिन्दी", false, true, true},   // Devanagari vowel signs are marks
+		{"ﬁsh", true, true, true},       // ligature, a letter
+		{"x²", false, false, true},
+		{"hello-world", true, true, true},
+		{"hello--world", false, false, false},
+		{"hello world", false, false, false},
+	}
+
+	nfc := UTF8Options{Normalize: true, Form: norm.NFC}
+	nfkc := UTF8Options{Normalize: true, Form: norm.NFKC}
+
+	for _, l := range list {
+		if result := IsUTF8SlugWith(l.field, UTF8Options{}); result != l.plain || result != IsUTF8Slug(l.field) {
+			t.Errorf("IsUTF8SlugWith(%q): Valid[%t]. Expected: %t", l.field, result, l.plain)
+		}
+		if result := IsUTF8SlugWith(l.field, nfc); result != l.nfc {
+			t.Errorf("IsUTF8SlugWith(%q, NFC): Valid[%t]. Expected: %t", l.field, result, l.nfc)
+		}
+		if result := IsUTF8SlugWith(l.field, nfkc); result != l.nfkc {
+			t.Errorf("IsUTF8SlugWith(%q, NFKC): Valid[%t]. Expected: %t", l.field, result, l.nfkc)
+		}
+	}
+}
+
+func TestIsUTF8ItemTagWith(t *testing.T) {
+	var list = []struct {
+		field string
+		plain bool
+		nfc   bool
+		nfkc  bool
+	}{
+		{"café au lait", true, true, true},
+		{"cafe\u0301 au lait", false, true, true},
+		{"ＡＢＣ　ＤＥＦ", false, false, true}, // ideographic space
+		{"ＡＢＣ１ 2", false, false, true},
+		{"café-au-lait", false, false, false},
+	}
+
+	nfc := UTF8Options{Normalize: true}
+	nfkc := UTF8Options{Normalize: true, Form: norm.NFKC}
+
+	for _, l := range list {
+		if result := IsUTF8ItemTagWith(l.field, UTF8Options{}); result != l.plain || result != IsUTF8ItemTag(l.field) {
+			t.Errorf("IsUTF8ItemTagWith(%q): Valid[%t]. Expected: %t", l.field, result, l.plain)
+		}
+		if result := IsUTF8ItemTagWith(l.field, nfc); result != l.nfc {
+			t.Errorf("IsUTF8ItemTagWith(%q, NFC): Valid[%t]. Expected: %t", l.field, result, l.nfc)
+		}
+		if result := IsUTF8ItemTagWith(l.field, nfkc); result != l.nfkc {
+			t.Errorf("IsUTF8ItemTagWith(%q, NFKC): Valid[%t]. Expected: %t", l.field, result, l.nfkc)
+		}
+	}
+}
+
+func TestNormalizeUTF8(t *testing.T) {
+	if result := NormalizeUTF8("cafe\u0301", UTF8Options{}); result != "cafe\u0301" {
+		t.Errorf("NormalizeUTF8(): Result[%q]. Expected the input", result)
+	}
+	if result := NormalizeUTF8("cafe\u0301", UTF8Options{Normalize: true}); result != "café" {
+		t.Errorf("NormalizeUTF8(NFC): Result[%q]. Expected: café", result)
+	}
+	if result := NormalizeUTF8("ＡＢＣ１", UTF8Options{Normalize: true, Form: norm.NFKC}); result != "ABC1" {
+		t.Errorf("NormalizeUTF8(NFKC): Result[%q]. Expected: ABC1", result)
+	}
+}
+
+func TestNormalizeUTF8Lowercase(t *testing.T) {
+	lt := language.MustParse("lt")
+	az := language.MustParse("az")
+
+	var list = []struct {
+		field       string
+		lang        language.Tag
+		expectation string
+	}{
+		{"İSTANBUL", language.Und, "i\u0307stanbul"}, // the default rules keep the dot as a combining mark
+		{"İSTANBUL", language.Turkish, "istanbul"},
+		{"DİYARBAKIR", language.Turkish, "diyarbakır"},
+		{"DİYARBAKIR", az, "diyarbakır"},
+		{"DIYARBAKIR", language.English, "diyarbakir"},
+		{"ÌS", lt, "i\u0307\u0300s"}, // Lithuanian keeps the dot under the accent
+		{"ÌS", language.Und, "\u00ecs"},
+		{"HELLO-WORLD", language.Und, "hello-world"},
+	}
+
+	for _, l := range list {
+		opt := UTF8Options{Normalize: true, Lowercase: true, Language: l.lang}
+
+		if result := NormalizeUTF8(l.field, opt); result != l.expectation {
+			t.Errorf("NormalizeUTF8(%v, %s): Result[%q]. Expected: %q", l.field, l.lang, result, l.expectation)
+		}
+	}
+
+	// the validators check the lowercase form
+	if !IsUTF8SlugWith("DİYARBAKIR", UTF8Options{Normalize: true, Lowercase: true, Language: language.Turkish}) {
+		t.Errorf("IsUTF8SlugWith(DİYARBAKIR, tr): Valid[false]. Expected: true")
+	}
+	if !IsUTF8ItemTagWith("İSTANBUL ANKARA", UTF8Options{Lowercase: true, Language: language.Turkish}) {
+		t.Errorf("IsUTF8ItemTagWith(İSTANBUL ANKARA, tr): Valid[false]. Expected: true")
+	}
+}
+
+func TestSluggerLanguageCase(t *testing.T) {
+	var list = []struct {
+		field       string
+		lang        language.Tag
+		expectation string
+	}{
+		{"İSTANBUL", language.Und, "istanbul"},
+		{"İSTANBUL", language.Turkish, "istanbul"},
+		{"DİYARBAKIR", language.Turkish, "diyarbakir"},
+		{"Straße", language.German, "strasse"},
+	}
+
+	for _, l := range list {
+		if result := (Slugger{Language: l.lang}).Slug(l.field); result != l.expectation {
+			t.Errorf("Slugger{%s}.Slug(%v): Result[%s]. Expected: %s", l.lang, l.field, result, l.expectation)
+		}
+	}
+
+	// tags are lowercased with the same rules
+	if _, slugs := (Slugger{Language: language.Turkish}).GetTagsAndTagSlugs("İZMİR,Izmir"); !sliceEqual(slugs, []string{"izmir"}) {
+		t.Errorf("Slugger{tr}.GetTagsAndTagSlugs(): Result[%q]. Expected: [izmir]", slugs)
+	}
+}
@@ -0,0 +1,212 @@
+package slug
+
+import (
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// how the dots of decimals ("3.14") and versions ("1.21.3", at least two dots) are written in a slug
+type NumberFormat int
+
+const (
+	NumberSplit    NumberFormat = iota // the dot is a separator like in GetAsciiSlug, "3.14" -> "3-14"
+	NumberJoin                         // the dot is removed, "1.21.3" -> "1213"
+	NumberKeepDots                     // the dot is kept, "1.21.3" -> "1.21.3", these slugs pass Slugger.Validate but not IsSlug
+)
+
+// marks a kept dot between the steps of the pipeline, a private use character
+const numberDot = '\ue000'
+
+// decimal and digit group separators, see byLanguage
+var numberSeparators = map[string][2]rune{
+	"en": {'.', ','},
+	"de": {',', '.'}, "es": {',', '.'}, "it": {',', '.'}, "pt": {',', '.'}, "nl": {',', '.'}, "da": {',', '.'}, "tr": {',', '.'}, "id": {',', '.'},
+	"fr": {',', ' '}, "ru": {',', ' '}, "pl": {',', ' '}, "cs": {',', ' '}, "sv": {',', ' '}, "fi": {',', ' '}, "nb": {',', ' '},
+}
+
+// words for a minus sign before a number, see byLanguage
+var minusWords = map[string]string{
+	"en": "minus", "de": "minus", "fr": "moins", "es": "menos", "it": "meno", "pt": "menos", "nl": "min",
+}
+
+func languageBase(lang language.Tag) string {
+	base, _ := lang.Base()
+	return base.String()
+}
+
+// the entry of a table by base language, so "de-AT" gets the German one, English is used for languages missing from the table
+func byLanguage[T any](table map[string]T, lang language.Tag) T {
+	if entry, found := table[languageBase(lang)]; found {
+		return entry
+	}
+	return table["en"]
+}
+
+func isDigit(c rune) bool {
+	return c >= '0' && c <= '9'
+}
+
+// spaces used between digit groups, a normal space is only a group separator for languages that use spaces
+func isGroupSeparator(c, group rune) bool {
+	if group == ' ' {
+		return c == ' ' || c == '\u00a0' || c == '\u202f'
+	}
+	return c == group
+}
+
+/*
+	Rewrites the numbers of title with the number options of the Slugger.
+	A number is a run of ASCII digits separated by single dots, commas or digit group separators of the language.
+	With Thousands, groups of three digits after the first group are joined ("1,000,000" -> "1000000", "1.000.000" in German).
+	Then a number with one decimal separator is a decimal and a number with two or more dots is a version.
+*/
+func (s Slugger) formatNumbers(title string) string {
+	seps := byLanguage(numberSeparators, s.Language)
+	decimal, group := seps[0], seps[1]
+
+	runes := []rune(title)
+
+	var b strings.Builder
+	b.Grow(len(title))
+
+	for i := 0; i < len(runes); {
+		c := runes[i]
+
+		// the transliteration drops private use characters anyway, so the marker cannot come from the title
+		if c == numberDot {
+			i++
+			continue
+		}
+
+		if s.Negatives && (c == '-' || c == '\u2212') && i+1 < len(runes) && isDigit(runes[i+1]) &&
+			(i == 0 || runes[i-1] == ' ' || runes[i-1] == '(' || runes[i-1] == '\t') {
+			b.WriteString(" " + byLanguage(minusWords, s.Language) + " ")
+			i++
+			continue
+		}
+
+		if !isDigit(c) {
+			b.WriteRune(c)
+			i++
+			continue
+		}
+
+		// the digit groups and the separators between them
+		var groups []string
+		var seps []rune
+		j := i
+		for {
+			k := j
+			for k < len(runes) && isDigit(runes[k]) {
+				k++
+			}
+			groups = append(groups, string(runes[j:k]))
+			j = k
+
+			if j+1 < len(runes) && isDigit(runes[j+1]) && (runes[j] == '.' || runes[j] == ',' || isGroupSeparator(runes[j], group)) {
+				seps = append(seps, runes[j])
+				j++
+				continue
+			}
+			break
+		}
+
+		b.WriteString(s.formatNumber(groups, seps, decimal, group))
+		i = j
+	}
+
+	return b.String()
+}
+
+// writes one number, see formatNumbers
+func (s Slugger) formatNumber(groups []string, seps []rune, decimal, group rune) string {
+	var b strings.Builder
+
+	// the number of leading groups that are digit groups of one number
+	grouped := 0
+	if s.Thousands && len(groups[0]) <= 3 && groups[0][0] != '0' {
+		for grouped < len(seps) && isGroupSeparator(seps[grouped], group) && len(groups[grouped+1]) == 3 {
+			grouped++
+		}
+		// a following group separator means the groups were something else (Ex: "1,000,00")
+		if grouped < len(seps) && isGroupSeparator(seps[grouped], group) {
+			grouped = 0
+		}
+	}
+
+	dots := 0
+	for _, sep := range seps[grouped:] {
+		if sep == '.' {
+			dots++
+		}
+	}
+
+	format := NumberSplit
+	if dots >= 2 {
+		format = s.Versions
+	} else if len(seps)-grouped == 1 && seps[grouped] == decimal {
+		format = s.Decimals
+	}
+
+	b.WriteString(groups[0])
+	for n, sep := range seps {
+		switch {
+		case n < grouped:
+			// joined digit groups
+		case format == NumberJoin && (sep == '.' || sep == decimal):
+		case format == NumberKeepDots && (sep == '.' || sep == decimal):
+			b.WriteRune(numberDot)
+		default:
+			b.WriteRune(sep)
+		}
+		b.WriteString(groups[n+1])
+	}
+
+	return b.String()
+}
+
+/*
+	Same as Slugger.AppendSlug after the title was prepared, kept dots are written between the slugs of the parts.
+	A dot is only kept between two digits of neighbouring parts, otherwise the parts are separated with a hypthen and blank parts are skipped.
+*/
+func appendSlugWithDots(dst []byte, title string) []byte {
+	start, blank := len(dst), false
+
+	for _, part := range strings.Split(title, string(numberDot)) {
+		end := len(dst)
+		dst = AppendSlug(dst, part)
+
+		if len(dst) == end {
+			blank = true
+			continue
+		}
+		if end == start {
+			blank = false
+			continue
+		}
+
+		sep := byte('-')
+		if !blank && isDigit(rune(dst[end-1])) && isDigit(rune(dst[end])) {
+			sep = '.'
+		}
+		blank = false
+
+		dst = append(dst, 0)
+		copy(dst[end+1:], dst[end:])
+		dst[end] = sep
+	}
+
+	return dst
+}
+
+// whether sl is a slug where every dot is between two digits
+func isSlugWithDots(sl string) bool {
+	for i := 0; i < len(sl); i++ {
+		if sl[i] == '.' && (i == 0 || i+1 == len(sl) || !isDigit(rune(sl[i-1])) || !isDigit(rune(sl[i+1]))) {
+			return false
+		}
+	}
+
+	return IsSlug(strings.Replace(sl, ".", "-", -1))
+}
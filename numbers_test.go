@@ -0,0 +1,120 @@
+package slug
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestSluggerNumbers(t *testing.T) {
+	var list = []struct {
+		slugger     Slugger
+		field       string
+		expectation string
+	}{
+		{Slugger{}, "Go 1.21.3 released", "go-1-21-3-released"},
+		{Slugger{}, "Pi is 3.14", "pi-is-3-14"},
+		{Slugger{}, "1,000,000 users", "1-000-000-users"},
+		{Slugger{}, "-5 degrees", "5-degrees"},
+
+		{Slugger{Versions: NumberJoin}, "Go 1.21.3 released", "go-1213-released"},
+		{Slugger{Versions: NumberJoin}, "v1.2.3", "v123"},
+		{Slugger{Versions: NumberJoin}, "Pi is 3.14", "pi-is-3-14"}, // one dot is a decimal
+		{Slugger{Versions: NumberKeepDots}, "Go 1.21.3 released", "go-1.21.3-released"},
+		{Slugger{Versions: NumberKeepDots}, "Go 1.21.3.", "go-1.21.3"},
+		{Slugger{Decimals: NumberJoin}, "Pi is 3.14", "pi-is-314"},
+		{Slugger{Decimals: NumberKeepDots}, "Pi is 3.14.", "pi-is-3.14"},
+		{Slugger{Decimals: NumberKeepDots}, "Pi is 3.14", "pi-is-3.14"},
+		{Slugger{Decimals: NumberKeepDots}, "Pi is 3,14", "pi-is-3-14"},
+		{Slugger{Decimals: NumberKeepDots, Language: language.German}, "Pi ist 3,14", "pi-ist-3.14"},
+		{Slugger{Decimals: NumberKeepDots}, "Section 3. Intro", "section-3-intro"},
+
+		{Slugger{Thousands: true}, "1,000,000 users", "1000000-users"},
+		{Slugger{Thousands: true}, "1,000.50 dollars", "1000-50-dollars"},
+		{Slugger{Thousands: true, Decimals: NumberKeepDots}, "1,000.50 dollars", "1000.50-dollars"},
+		{Slugger{Thousands: true}, "1,2,3", "1-2-3"},
+		{Slugger{Thousands: true}, "1,000,00", "1-000-00"},
+		{Slugger{Thousands: true}, "0,500", "0-500"},
+		{Slugger{Thousands: true, Language: language.German}, "1.000.000 Nutzer", "1000000-nutzer"},
+		{Slugger{Thousands: true, Language: language.French}, "1 000 000 utilisateurs", "1000000-utilisateurs"},
+		{Slugger{Thousands: true, Language: language.French}, "1 000 euros", "1000-euros"},
+		{Slugger{Thousands: true}, "1 000 users", "1-000-users"}, // spaces are only separators in some languages
+
+		{Slugger{Negatives: true}, "-5 degrees", "minus-5-degrees"},
+		{Slugger{Negatives: true}, "From -5 to 5", "from-minus-5-to-5"},
+		{Slugger{Negatives: true}, "(−3)", "minus-3"},
+		{Slugger{Negatives: true}, "COVID-19", "covid-19"},
+		{Slugger{Negatives: true}, "10-5", "10-5"},
+		{Slugger{Negatives: true, Language: language.French}, "-5 degres", "moins-5-degres"},
+	}
+
+	for _, l := range list {
+		if result := l.slugger.Slug(l.field); result != l.expectation {
+			t.Errorf("Slugger%+v.Slug(%v): Result[%s]. Expected: %s", l.slugger, l.field, result, l.expectation)
+		}
+	}
+}
+
+func TestSluggerValidateDots(t *testing.T) {
+	var list = []struct {
+		field string
+		plain bool
+		dots  bool
+	}{
+		{"go-1-21-3", true, true},
+		{"go-1.21.3", false, true},
+		{"3.14", false, true},
+		{"go.lang", false, false},
+		{"1.", false, false},
+		{".1", false, false},
+		{"1..2", false, false},
+		{"1-.2", false, false},
+	}
+
+	for _, l := range list {
+		if valid := (Slugger{}).Validate(l.field) == nil; valid != l.plain {
+			t.Errorf("Slugger{}.Validate(%v): Valid[%t]. Expected: %t", l.field, valid, l.plain)
+		}
+		if valid := (Slugger{Versions: NumberKeepDots}).Validate(l.field) == nil; valid != l.dots {
+			t.Errorf("Slugger{NumberKeepDots}.Validate(%v): Valid[%t]. Expected: %t", l.field, valid, l.dots)
+		}
+	}
+}
+
+func TestNumberKeepDotsOptions(t *testing.T) {
+	bad := NewBlockedWords("bad")
+	substrings := NewBlockedWords("21")
+	substrings.Substrings = true
+
+	var list = []struct {
+		slugger     Slugger
+		field       string
+		expectation string
+	}{
+		{Slugger{Versions: NumberKeepDots, Blocked: bad, OnBlocked: BlockedRemove}, "Go 1.21.3 bad release", "go-1.21.3-release"},
+		{Slugger{Versions: NumberKeepDots, Blocked: bad, OnBlocked: BlockedMask}, "Go 1.21.3 bad release", "go-1.21.3-xxx-release"},
+		{Slugger{Decimals: NumberKeepDots, Blocked: bad, OnBlocked: BlockedRemove}, "bad 3.14 pie", "3.14-pie"},
+		{Slugger{Versions: NumberKeepDots, Blocked: substrings, OnBlocked: BlockedRemove}, "Go 1.21.3", "go-1-3"},
+	}
+
+	for _, l := range list {
+		if result := l.slugger.Slug(l.field); result != l.expectation {
+			t.Errorf("Slugger.Slug(%v): Result[%s]. Expected: %s", l.field, result, l.expectation)
+		}
+	}
+
+	// identifiers cannot contain dots
+	s := Slugger{Versions: NumberKeepDots}
+	var identifiers = []stringStruct{
+		{s.SnakeCase("Go 1.21.3"), "go_1_21_3"},
+		{s.ConstantCase("Go 1.21.3"), "GO_1_21_3"},
+		{s.CamelCase("Go 1.21.3"), "go1_21_3"},
+		{s.PascalCase("1.21.3 release"), "_1_21_3Release"},
+	}
+
+	for _, l := range identifiers {
+		if l.field != l.expectation {
+			t.Errorf("Slugger{NumberKeepDots} identifier: Result[%s]. Expected: %s", l.field, l.expectation)
+		}
+	}
+}
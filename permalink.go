@@ -0,0 +1,210 @@
+package slug
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrPermalinkTemplate = errors.New("The permalink template is not valid.")
+	ErrPermalinkMismatch = errors.New("The path does not match the permalink template.")
+	ErrPermalinkItem     = errors.New("The item has a date or ID that the permalink template cannot hold.")
+)
+
+// the regexp of every placeholder, slugs and categories are checked with IsSlug after matching
+var permalinkPlaceholders = map[string]string{
+	"year":     `([0-9]{4})`,
+	"month":    `([0-9]{2})`,
+	"day":      `([0-9]{2})`,
+	"id":       `([0-9]+)`,
+	"slug":     `([^/]+)`,
+	"category": `([^/]+)`,
+}
+
+// the parts of a permalink, Parse only fills the parts that are in the template
+type PermalinkItem struct {
+	Date     time.Time
+	ID       int64
+	Slug     string
+	Category string
+}
+
+/*
+	A compiled permalink template, the placeholders {year}, {month}, {day}, {id}, {slug} and {category} can appear once each,
+	anywhere in the path (Ex: "/{year}/{month}/{day}/{slug}", "/blog/{category}/{slug}-{id}.html").
+
+		t, _ := slug.NewPermalinkTemplate("/{year}/{month}/{day}/{slug}")
+		t.Format(item)                      // "/2017/12/29/hello-world"
+		t.Parse("/2017/12/29/hello-world")  // the date and the slug of the item
+*/
+type PermalinkTemplate struct {
+	pattern string
+	names   []string // placeholders in the order of the regexp groups
+	re      *regexp.Regexp
+}
+
+func NewPermalinkTemplate(pattern string) (*PermalinkTemplate, error) {
+	t := &PermalinkTemplate{pattern: pattern}
+	seen := make(map[string]bool)
+	prev := "" // the previous placeholder, open is 0 when nothing is between them
+
+	var expr strings.Builder
+	expr.WriteString("^")
+
+	for rest := pattern; rest != ""; {
+		open := strings.IndexAny(rest, "{}")
+		if open < 0 {
+			expr.WriteString(regexp.QuoteMeta(rest))
+			break
+		}
+
+		closing := strings.IndexByte(rest[open:], '}')
+		if rest[open] == '}' || closing < 0 {
+			return nil, ErrPermalinkTemplate
+		}
+
+		name := rest[open+1 : open+closing]
+		group, found := permalinkPlaceholders[name]
+		if !found || seen[name] {
+			return nil, ErrPermalinkTemplate
+		}
+		seen[name] = true
+
+		// the end of a placeholder without a fixed width is only known from the literal text after it (Ex: "{slug}{id}")
+		if open == 0 && (prev == "slug" || prev == "category" || prev == "id") {
+			return nil, ErrPermalinkTemplate
+		}
+		prev = name
+
+		expr.WriteString(regexp.QuoteMeta(rest[:open]))
+		expr.WriteString(group)
+		t.names = append(t.names, name)
+
+		rest = rest[open+closing+1:]
+	}
+
+	expr.WriteString("$")
+	t.re = regexp.MustCompile(expr.String())
+
+	return t, nil
+}
+
+// same as NewPermalinkTemplate, panics if the template is not valid, for templates known at compile time
+func MustPermalinkTemplate(pattern string) *PermalinkTemplate {
+	t, err := NewPermalinkTemplate(pattern)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func (t *PermalinkTemplate) String() string {
+	return t.pattern
+}
+
+/*
+	The URL path of item, only the parts in the template are used and Parse reads the path back.
+	The error is ErrInvalidSlug when the slug or the category does not pass IsSlug, and ErrPermalinkItem
+	for a zero date or a year after 9999 ({year} has 4 digits) and for a negative ID.
+*/
+func (t *PermalinkTemplate) Format(item PermalinkItem) (string, error) {
+	values := make([]string, 0, 2*len(t.names))
+
+	for _, name := range t.names {
+		var value string
+
+		switch name {
+		case "year", "month", "day":
+			if item.Date.IsZero() || item.Date.Year() < 0 || item.Date.Year() > 9999 {
+				return "", ErrPermalinkItem
+			}
+		case "id":
+			if item.ID < 0 {
+				return "", ErrPermalinkItem
+			}
+		}
+
+		switch name {
+		case "year":
+			value = fmt.Sprintf("%04d", item.Date.Year())
+		case "month":
+			value = fmt.Sprintf("%02d", int(item.Date.Month()))
+		case "day":
+			value = fmt.Sprintf("%02d", item.Date.Day())
+		case "id":
+			value = strconv.FormatInt(item.ID, 10)
+		case "slug":
+			value = item.Slug
+		case "category":
+			value = item.Category
+		}
+
+		if (name == "slug" || name == "category") && !IsSlug(value) {
+			return "", ErrInvalidSlug
+		}
+
+		values = append(values, "{"+name+"}", value)
+	}
+
+	return strings.NewReplacer(values...).Replace(t.pattern), nil
+}
+
+/*
+	Splits a URL path into the parts of the template, the error is ErrPermalinkMismatch when the path has another shape or an impossible date,
+	and ErrInvalidSlug when the slug or the category does not pass IsSlug. A date without a day or month in the template starts on the first day.
+*/
+func (t *PermalinkTemplate) Parse(path string) (PermalinkItem, error) {
+	var item PermalinkItem
+
+	match := t.re.FindStringSubmatch(path)
+	if match == nil {
+		return item, ErrPermalinkMismatch
+	}
+
+	year, month, day, hasDate := 1, 1, 1, false
+
+	for i, name := range t.names {
+		value := match[i+1]
+
+		switch name {
+		case "year":
+			year, _ = strconv.Atoi(value)
+			hasDate = true
+		case "month":
+			month, _ = strconv.Atoi(value)
+			hasDate = true
+		case "day":
+			day, _ = strconv.Atoi(value)
+			hasDate = true
+		case "id":
+			id, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return item, ErrPermalinkMismatch
+			}
+			item.ID = id
+		case "slug", "category":
+			if !IsSlug(value) {
+				return item, ErrInvalidSlug
+			}
+			if name == "slug" {
+				item.Slug = value
+			} else {
+				item.Category = value
+			}
+		}
+	}
+
+	if hasDate {
+		// time.Date normalizes out of range values, "2017/02/30" would become March 2
+		item.Date = time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+		if item.Date.Year() != year || int(item.Date.Month()) != month || item.Date.Day() != day {
+			return PermalinkItem{}, ErrPermalinkMismatch
+		}
+	}
+
+	return item, nil
+}
@@ -0,0 +1,141 @@
+package slug
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewPermalinkTemplate(t *testing.T) {
+	var list = []struct {
+		pattern string
+		valid   bool
+	}{
+		{"/{year}/{month}/{day}/{slug}", true},
+		{"/blog/{category}/{slug}", true},
+		{"/{slug}-{id}.html", true},
+		{"/posts/", true},
+		{"/{year}/{slug", false},
+		{"/{year}}/{slug}", false},
+		{"/{author}/{slug}", false},
+		{"/{slug}/{slug}", false},
+		{"/{}/{slug}", false},
+		{"/{slug}{id}", false},
+		{"/{id}{slug}", false},
+		{"/{category}{year}", false},
+		{"/{year}{month}{day}-{slug}", true},
+		{"/{slug}-{id}", true},
+	}
+
+	for _, l := range list {
+		if _, err := NewPermalinkTemplate(l.pattern); (err == nil) != l.valid {
+			t.Errorf("NewPermalinkTemplate(%v): Error[%v]. Expected valid: %v", l.pattern, err, l.valid)
+		}
+	}
+}
+
+func TestPermalinkFormat(t *testing.T) {
+	item := PermalinkItem{
+		Date:     time.Date(2017, time.December, 29, 15, 4, 5, 0, time.UTC),
+		ID:       42,
+		Slug:     "hello-world",
+		Category: "news",
+	}
+
+	var list = []stringStruct{
+		{"/{year}/{month}/{day}/{slug}", "/2017/12/29/hello-world"},
+		{"/blog/{category}/{slug}", "/blog/news/hello-world"},
+		{"/{year}/{month}/{slug}-{id}.html", "/2017/12/hello-world-42.html"},
+		{"/p/{id}", "/p/42"},
+	}
+
+	for _, l := range list {
+		result, err := MustPermalinkTemplate(l.field).Format(item)
+		if err != nil || result != l.expectation {
+			t.Errorf("Format(%v): Result[%s] Error[%v]. Expected: %s", l.field, result, err, l.expectation)
+		}
+	}
+
+	item.Slug = "Hello World"
+	if _, err := MustPermalinkTemplate("/{slug}").Format(item); err != ErrInvalidSlug {
+		t.Errorf("Format(Hello World): Error[%v]. Expected: %v", err, ErrInvalidSlug)
+	}
+
+	// values that Parse could not read back
+	item.Slug = "hello-world"
+	var invalid = []struct {
+		pattern string
+		item    PermalinkItem
+	}{
+		{"/p/{id}", PermalinkItem{ID: -5}},
+		{"/{year}/{slug}", PermalinkItem{Date: time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC), Slug: "hello-world"}},
+		{"/{year}/{month}/{day}/{slug}", PermalinkItem{Slug: "hello-world"}},
+		{"/{month}/{slug}", PermalinkItem{Slug: "hello-world"}},
+	}
+
+	for _, l := range invalid {
+		if result, err := MustPermalinkTemplate(l.pattern).Format(l.item); err != ErrPermalinkItem {
+			t.Errorf("Format(%v, %+v): Result[%s] Error[%v]. Expected: %v", l.pattern, l.item, result, err, ErrPermalinkItem)
+		}
+	}
+
+	// the date is not checked when it is not in the template
+	if result, err := MustPermalinkTemplate("/{slug}").Format(PermalinkItem{Slug: "hello-world"}); err != nil || result != "/hello-world" {
+		t.Errorf("Format(/{slug}): Result[%s] Error[%v]. Expected: /hello-world", result, err)
+	}
+
+	// the slug is not checked when it is not in the template
+	item.Slug = "Hello World"
+	if result, err := MustPermalinkTemplate("/p/{id}").Format(item); err != nil || result != "/p/42" {
+		t.Errorf("Format(/p/{id}): Result[%s] Error[%v]. Expected: /p/42", result, err)
+	}
+}
+
+func TestPermalinkParse(t *testing.T) {
+	var list = []struct {
+		pattern string
+		path    string
+		item    PermalinkItem
+		err     error
+	}{
+		{"/{year}/{month}/{day}/{slug}", "/2017/12/29/hello-world",
+			PermalinkItem{Date: time.Date(2017, 12, 29, 0, 0, 0, 0, time.UTC), Slug: "hello-world"}, nil},
+		{"/blog/{category}/{slug}", "/blog/news/hello-world", PermalinkItem{Slug: "hello-world", Category: "news"}, nil},
+		{"/{year}/{month}/{slug}-{id}.html", "/2017/12/hello-world-42.html",
+			PermalinkItem{Date: time.Date(2017, 12, 1, 0, 0, 0, 0, time.UTC), ID: 42, Slug: "hello-world"}, nil},
+		{"/{slug}-{id}", "/2nd-try-7", PermalinkItem{ID: 7, Slug: "2nd-try"}, nil},
+
+		{"/{year}/{month}/{day}/{slug}", "/2017/02/30/hello-world", PermalinkItem{}, ErrPermalinkMismatch},
+		{"/{year}/{month}/{day}/{slug}", "/2017/13/01/hello-world", PermalinkItem{}, ErrPermalinkMismatch},
+		{"/{year}/{month}/{day}/{slug}", "/17/12/29/hello-world", PermalinkItem{}, ErrPermalinkMismatch},
+		{"/{year}/{month}/{day}/{slug}", "/2017/12/29/hello/world", PermalinkItem{}, ErrPermalinkMismatch},
+		{"/blog/{category}/{slug}", "/news/hello-world", PermalinkItem{}, ErrPermalinkMismatch},
+		{"/blog/{category}/{slug}", "/blog/news/Hello_World", PermalinkItem{}, ErrInvalidSlug},
+		{"/blog/{category}/{slug}", "/blog/top%20news/hello-world", PermalinkItem{}, ErrInvalidSlug},
+		{"/p/{id}", "/p/99999999999999999999", PermalinkItem{}, ErrPermalinkMismatch},
+	}
+
+	for _, l := range list {
+		item, err := MustPermalinkTemplate(l.pattern).Parse(l.path)
+		if err != l.err {
+			t.Errorf("Parse(%v, %v): Error[%v]. Expected: %v", l.pattern, l.path, err, l.err)
+			continue
+		}
+		if err == nil && (!item.Date.Equal(l.item.Date) || item.ID != l.item.ID || item.Slug != l.item.Slug || item.Category != l.item.Category) {
+			t.Errorf("Parse(%v, %v): Result[%+v]. Expected: %+v", l.pattern, l.path, item, l.item)
+		}
+	}
+}
+
+func TestPermalinkRoundTrip(t *testing.T) {
+	tmpl := MustPermalinkTemplate("/{category}/{year}/{month}/{day}/{slug}")
+	item := PermalinkItem{Date: time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC), Slug: GetAsciiSlug("Leap day!"), Category: "misc"}
+
+	path, err := tmpl.Format(item)
+	if err != nil {
+		t.Fatalf("Format(): Error[%v]", err)
+	}
+
+	if parsed, err := tmpl.Parse(path); err != nil || parsed != item {
+		t.Errorf("Parse(%v): Result[%+v] Error[%v]. Expected: %+v", path, parsed, err, item)
+	}
+}
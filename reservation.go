@@ -0,0 +1,179 @@
+package slug
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+)
+
+var ErrClaimExpired = errors.New("The slug was not committed before its reservation expired.")
+
+// claims are removed from the map after this many new claims, so slugs that are never claimed again do not stay in memory
+const reservationPurgeInterval = 1024
+
+type reservation struct {
+	token   uint64
+	expires time.Time
+}
+
+/*
+	Reserves slugs in memory, so concurrent requests creating items with the same title get different slugs.
+	A claim holds the slug for the TTL, until it is committed (the item is saved) or released (the item is not saved).
+	An expired claim can be taken by another request.
+
+	Only the open claims are kept, the store of the items is the source of truth for the saved slugs.
+	exists reports whether a slug is saved (Ex: a database lookup), it is called without holding the lock, nil only checks the claims.
+
+		r := slug.NewReservations(time.Minute, postExists)
+		c := r.Claim(slug.GetAsciiSlug(title)) // c.Slug is "hello-world", or "hello-world-1" when taken
+		if err := save(item, c.Slug); err != nil {
+			c.Release()
+		} else {
+			c.Commit()
+		}
+
+	It is safe for use by multiple goroutines.
+*/
+type Reservations struct {
+	mu     sync.Mutex
+	ttl    time.Duration
+	exists func(sl string) bool
+	slugs  map[string]reservation
+	next   map[string]int // next number suffix of a slug, so a claim does not try the numbers again from 1
+	token  uint64
+	claims int
+
+	now func() time.Time // replaced by tests
+}
+
+func NewReservations(ttl time.Duration, exists func(sl string) bool) *Reservations {
+	return &Reservations{ttl: ttl, exists: exists, slugs: make(map[string]reservation), next: make(map[string]int), now: time.Now}
+}
+
+// a reserved slug, the zero value is not a claim
+type Claim struct {
+	Slug string
+
+	token uint64
+	r     *Reservations
+}
+
+// whether sl is claimed and not expired, or saved in the store
+func (r *Reservations) IsTaken(sl string) bool {
+	r.mu.Lock()
+	claimed := r.claimed(sl, r.now())
+	r.mu.Unlock()
+
+	return claimed || (r.exists != nil && r.exists(sl))
+}
+
+/*
+	Reserves sl, or the next free slug with a number suffix (Ex: "hello-world-1", "hello-world-2"), like ReservedSuffix.
+	The numbers are counted from the last one handed out, until the counter is purged, so released numbers are not reused right away.
+	An empty slug is never claimed, the returned claim has an empty Slug.
+*/
+func (r *Reservations) Claim(sl string) Claim {
+	if sl == "" {
+		return Claim{}
+	}
+
+	for suffix := false; ; suffix = true {
+		c := r.reserve(sl, suffix)
+
+		// the store is checked after the reservation, so no other request can save the same slug in between
+		if r.exists == nil || !r.exists(c.Slug) {
+			return c
+		}
+		c.Release()
+	}
+}
+
+// claims sl when it is free of open claims, or else (or with suffix) the next numbered slug free of open claims
+func (r *Reservations) reserve(sl string, suffix bool) Claim {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+
+	r.claims++
+	if r.claims%reservationPurgeInterval == 0 {
+		r.purge(now)
+	}
+
+	claimed := sl
+	if suffix || r.claimed(sl, now) {
+		n := r.next[sl]
+		if n == 0 {
+			n = 1
+		}
+
+		for r.claimed(sl+"-"+strconv.Itoa(n), now) {
+			n++
+		}
+
+		claimed = sl + "-" + strconv.Itoa(n)
+		r.next[sl] = n + 1
+	}
+
+	r.token++
+	r.slugs[claimed] = reservation{token: r.token, expires: now.Add(r.ttl)}
+
+	return Claim{Slug: claimed, token: r.token, r: r}
+}
+
+/*
+	Ends the claim once the item is saved, the store keeps the slug taken from then on.
+	The error is ErrClaimExpired when the claim was released, or when its TTL has passed and the slug was claimed by another request or purged.
+	An expired claim that is still in place is committed.
+*/
+func (c Claim) Commit() error {
+	if c.r == nil {
+		return ErrClaimExpired
+	}
+
+	c.r.mu.Lock()
+	defer c.r.mu.Unlock()
+
+	if res, found := c.r.slugs[c.Slug]; !found || res.token != c.token {
+		return ErrClaimExpired
+	}
+
+	delete(c.r.slugs, c.Slug)
+	return nil
+}
+
+// frees the slug for other requests, nothing happens when the claim was committed or already taken by another request
+func (c Claim) Release() {
+	if c.r == nil {
+		return
+	}
+
+	c.r.mu.Lock()
+	defer c.r.mu.Unlock()
+
+	if res, found := c.r.slugs[c.Slug]; found && res.token == c.token {
+		delete(c.r.slugs, c.Slug)
+	}
+}
+
+func (r *Reservations) claimed(sl string, now time.Time) bool {
+	res, found := r.slugs[sl]
+	return found && now.Before(res.expires)
+}
+
+// removes the expired claims and the suffix counters of slugs that are no longer claimed, the lock must be held
+// counting again from 1 is safe, every number is checked against the claims and the store
+func (r *Reservations) purge(now time.Time) {
+	for sl, res := range r.slugs {
+		if !now.Before(res.expires) {
+			delete(r.slugs, sl)
+		}
+	}
+
+	for sl := range r.next {
+		if _, found := r.slugs[sl]; !found {
+			delete(r.next, sl)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package slug
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// reservations with a clock moved by the test
+func newTestReservations(ttl time.Duration, exists func(sl string) bool) (*Reservations, *time.Time) {
+	now := time.Date(2017, 12, 29, 0, 0, 0, 0, time.UTC)
+	r := NewReservations(ttl, exists)
+	r.now = func() time.Time { return now }
+	return r, &now
+}
+
+// slugs saved by the tests, safe for use by multiple goroutines
+type testStore struct {
+	slugs sync.Map
+}
+
+func (s *testStore) exists(sl string) bool {
+	_, found := s.slugs.Load(sl)
+	return found
+}
+
+func TestReservationsClaim(t *testing.T) {
+	store := &testStore{}
+	store.slugs.Store("about", true)
+	store.slugs.Store("about-1", true)
+
+	r, _ := newTestReservations(time.Minute, store.exists)
+
+	var list = []stringStruct{
+		{"hello-world", "hello-world"},
+		{"hello-world", "hello-world-1"},
+		{"hello-world", "hello-world-2"},
+		{"about", "about-2"},
+		{"hello-world-1", "hello-world-1-1"},
+	}
+
+	for _, l := range list {
+		if c := r.Claim(l.field); c.Slug != l.expectation {
+			t.Errorf("Reservations.Claim(%v): Result[%s]. Expected: %s", l.field, c.Slug, l.expectation)
+		}
+	}
+
+	if c := r.Claim(""); c.Slug != "" || c.Commit() != ErrClaimExpired {
+		t.Errorf("Reservations.Claim(\"\"): Result[%s]. Expected an empty claim", c.Slug)
+	}
+
+	// the numbers continue from the last one, a released number is not tried again
+	c := r.Claim("hello-world")
+	c.Release()
+	if c.Slug != "hello-world-3" {
+		t.Errorf("Reservations.Claim(hello-world): Result[%s]. Expected: hello-world-3", c.Slug)
+	}
+	if c := r.Claim("hello-world"); c.Slug != "hello-world-4" {
+		t.Errorf("Reservations.Claim(hello-world): Result[%s]. Expected: hello-world-4", c.Slug)
+	}
+}
+
+func TestReservationsCommitRelease(t *testing.T) {
+	store := &testStore{}
+	r, now := newTestReservations(time.Minute, store.exists)
+
+	// a released slug can be claimed again
+	c := r.Claim("draft")
+	c.Release()
+	if r.IsTaken("draft") {
+		t.Errorf("Reservations.IsTaken(draft): Result[true] after Release. Expected: false")
+	}
+	if err := c.Commit(); err != ErrClaimExpired {
+		t.Errorf("Claim.Commit() after Release: Error[%v]. Expected: %v", err, ErrClaimExpired)
+	}
+
+	// a committed claim is dropped, the store keeps the slug taken
+	c = r.Claim("post")
+	store.slugs.Store(c.Slug, true)
+	if err := c.Commit(); err != nil {
+		t.Errorf("Claim.Commit(post): Error[%v]. Expected: <nil>", err)
+	}
+	if len(r.slugs) != 0 {
+		t.Errorf("Claim.Commit(post): %d claims kept. Expected: 0", len(r.slugs))
+	}
+	c.Release()
+	*now = now.Add(time.Hour)
+	if !r.IsTaken("post") {
+		t.Errorf("Reservations.IsTaken(post): Result[false] after Commit. Expected: true")
+	}
+	if next := r.Claim("post"); next.Slug != "post-1" {
+		t.Errorf("Reservations.Claim(post): Result[%s] after Commit. Expected: post-1", next.Slug)
+	}
+	if err := c.Commit(); err != ErrClaimExpired {
+		t.Errorf("Claim.Commit() twice: Error[%v]. Expected: %v", err, ErrClaimExpired)
+	}
+
+	// an expired claim is taken by the next request, and the first one can no longer commit or release it
+	first := r.Claim("news")
+	*now = now.Add(time.Minute)
+	if r.IsTaken("news") {
+		t.Errorf("Reservations.IsTaken(news): Result[true] after the TTL. Expected: false")
+	}
+
+	second := r.Claim("news")
+	if second.Slug != "news" {
+		t.Errorf("Reservations.Claim(news): Result[%s] after the TTL. Expected: news", second.Slug)
+	}
+	first.Release()
+	if err := first.Commit(); err != ErrClaimExpired {
+		t.Errorf("Claim.Commit() of an expired claim: Error[%v]. Expected: %v", err, ErrClaimExpired)
+	}
+	if err := second.Commit(); err != nil {
+		t.Errorf("Claim.Commit() of the new claim: Error[%v]. Expected: <nil>", err)
+	}
+
+	// an expired claim that nobody took can still be committed
+	late := r.Claim("late")
+	*now = now.Add(time.Hour)
+	if err := late.Commit(); err != nil {
+		t.Errorf("Claim.Commit() of an expired claim still in place: Error[%v]. Expected: <nil>", err)
+	}
+}
+
+func TestReservationsPurge(t *testing.T) {
+	r, now := newTestReservations(time.Second, nil)
+
+	for i := 0; i < reservationPurgeInterval-2; i++ {
+		r.Claim("item-" + strconv.Itoa(i%10))
+	}
+	r.Claim("old").Commit()
+
+	// the claims expire, and the claim of "new" is the one that purges
+	*now = now.Add(time.Second)
+	r.Claim("new")
+
+	if len(r.slugs) != 1 || len(r.next) != 0 {
+		t.Errorf("Reservations.Claim(): %d claims and %d counters after the purge. Expected: 1 and 0", len(r.slugs), len(r.next))
+	}
+}
+
+// run with -race, every goroutine must get a different slug
+func TestReservationsConcurrent(t *testing.T) {
+	const workers = 50
+	const claims = 40
+
+	store := &testStore{}
+	r := NewReservations(time.Minute, store.exists)
+
+	var wg sync.WaitGroup
+	results := make(chan Claim, workers*claims)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+
+			for i := 0; i < claims; i++ {
+				c := r.Claim("hello-world")
+
+				// release some claims and save the others, the slug is saved before the claim is committed
+				if (w+i)%3 == 0 {
+					c.Release()
+					continue
+				}
+				if _, loaded := store.slugs.LoadOrStore(c.Slug, true); loaded {
+					t.Errorf("Reservations.Claim(): %s was already saved", c.Slug)
+				}
+				if err := c.Commit(); err != nil {
+					t.Errorf("Claim.Commit(%s): Error[%v]. Expected: <nil>", c.Slug, err)
+				}
+				results <- c
+			}
+		}(w)
+	}
+
+	wg.Wait()
+	close(results)
+
+	count := 0
+	for c := range results {
+		count++
+		if !r.IsTaken(c.Slug) {
+			t.Errorf("Reservations.IsTaken(%s): Result[false]. Expected: true", c.Slug)
+		}
+	}
+
+	if len(r.slugs) != 0 {
+		t.Errorf("Reservations: %d claims left after every claim was committed or released. Expected: 0", len(r.slugs))
+	}
+	if count == 0 {
+		t.Errorf("Reservations.Claim(): no claims were committed")
+	}
+}
@@ -0,0 +1,82 @@
+package slug
+
+import (
+	"strconv"
+	"strings"
+)
+
+/*
+	A set of slugs that cannot be used, usually because they collide with routes of the site.
+	Words are compared ignoring case and hypthens, so "Log-In", "log_in" and "login" are the same word.
+*/
+type ReservedWords struct {
+	words map[string]bool
+}
+
+// route names that most sites use for something other than content
+var defaultReservedWords = []string{
+	"about", "account", "admin", "administrator", "api", "app", "assets", "auth", "blog", "cdn", "config", "contact",
+	"css", "dashboard", "delete", "docs", "download", "edit", "favicon", "feed", "files", "help", "home", "images",
+	"img", "index", "js", "login", "logout", "media", "new", "oauth", "password", "privacy", "profile", "register",
+	"reset", "robots", "root", "rss", "search", "settings", "signin", "signout", "signup", "sitemap", "static",
+	"status", "support", "system", "tag", "tags", "terms", "upload", "user", "users", "www",
+}
+
+// the default route names, shared by every user of the package so it must not be changed, use NewDefaultReservedWords to extend them
+var DefaultReservedWords = NewDefaultReservedWords()
+
+func NewReservedWords(words ...string) *ReservedWords {
+	r := &ReservedWords{words: make(map[string]bool)}
+	r.Add(words...)
+	return r
+}
+
+// a new set of the default route names, that can be extended with Add
+func NewDefaultReservedWords() *ReservedWords {
+	return NewReservedWords(defaultReservedWords...)
+}
+
+// the key used for comparing, the ascii slug without hypthens
+func reservedKey(word string) string {
+	return strings.Replace(GetAsciiSlug(word), "-", "", -1)
+}
+
+func (r *ReservedWords) Add(words ...string) {
+	for _, word := range words {
+		if key := reservedKey(word); key != "" {
+			r.words[key] = true
+		}
+	}
+}
+
+func (r *ReservedWords) IsReserved(sl string) bool {
+	return r.words[reservedKey(sl)]
+}
+
+// what Slugger does with a generated slug that is reserved
+type ReservedAction int
+
+const (
+	ReservedReject ReservedAction = iota // the slug is blank, so it fails IsSlug like a title without letters or numbers
+	ReservedSuffix                       // a number is added (Ex: "admin" -> "admin-1")
+)
+
+// applies the reserved action to the slug written after start in dst
+func (s Slugger) appendReserved(dst []byte, start int) []byte {
+	if s.Reserved == nil || !s.Reserved.IsReserved(string(dst[start:])) {
+		return dst
+	}
+
+	if s.OnReserved == ReservedReject {
+		return dst[:start]
+	}
+
+	end := len(dst)
+	for n := 1; ; n++ {
+		dst = append(append(dst[:end], '-'), strconv.Itoa(n)...)
+
+		if !s.Reserved.IsReserved(string(dst[start:])) {
+			return dst
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package slug
+
+import (
+	"testing"
+
+	fv "github.com/dholtzmann/formvalidator"
+)
+
+func TestIsReserved(t *testing.T) {
+	var list = []defaultStruct{
+		{"admin", true},
+		{"Admin", true},
+		{"ADMIN", true},
+		{"log-in", true},
+		{"Log In", true},
+		{"log_in", true},
+		{"sign-up", true},
+		{"api", true},
+		{"a-p-i", true},
+		{"apis", false},
+		{"admin-panel", false},
+		{"hello-world", false},
+		{"", false},
+	}
+
+	for _, l := range list {
+		if result := DefaultReservedWords.IsReserved(l.field); result != l.expectation {
+			t.Errorf("IsReserved(%v): Result[%t]. Expected: %t", l.field, result, l.expectation)
+		}
+	}
+
+	r := NewReservedWords("Hello World", "~!@")
+	if !r.IsReserved("helloworld") || r.IsReserved("") || r.IsReserved("admin") {
+		t.Errorf("NewReservedWords(): unexpected words %v", r.words)
+	}
+
+	// a copy of the defaults is extended without changing the shared list
+	r = NewDefaultReservedWords()
+	r.Add("shop")
+	if !r.IsReserved("shop") || !r.IsReserved("admin") || DefaultReservedWords.IsReserved("shop") {
+		t.Errorf("NewDefaultReservedWords().Add(shop): the copy or the default list has the wrong words")
+	}
+}
+
+func TestSluggerReserved(t *testing.T) {
+	reserved := NewReservedWords("admin", "login", "admin-1")
+
+	var list = []struct {
+		field  string
+		reject string
+		suffix string
+	}{
+		{"Hello world!", "hello-world", "hello-world"},
+		{"Admin", "", "admin-2"}, // admin-1 is reserved too
+		{"Log In", "", "log-in-1"},
+		{"LOGIN!!!", "", "login-1"},
+		{"Admin panel", "admin-panel", "admin-panel"},
+	}
+
+	for _, l := range list {
+		if result := (Slugger{Reserved: reserved}).Slug(l.field); result != l.reject {
+			t.Errorf("Slugger{ReservedReject}.Slug(%q): Result[%s]. Expected: %s", l.field, result, l.reject)
+		}
+
+		if result := (Slugger{Reserved: reserved, OnReserved: ReservedSuffix}).Slug(l.field); result != l.suffix {
+			t.Errorf("Slugger{ReservedSuffix}.Slug(%q): Result[%s]. Expected: %s", l.field, result, l.suffix)
+		}
+	}
+
+	// the existing content of dst is not part of the slug
+	if result := string((Slugger{Reserved: reserved, OnReserved: ReservedSuffix}).AppendSlug([]byte("/"), "admin")); result != "/admin-2" {
+		t.Errorf("Slugger{ReservedSuffix}.AppendSlug(): Result[%s]. Expected: /admin-2", result)
+	}
+	if result := string((Slugger{Reserved: reserved}).AppendSlug([]byte("/"), "admin")); result != "/" {
+		t.Errorf("Slugger{ReservedReject}.AppendSlug(): Result[%s]. Expected: /", result)
+	}
+}
+
+func TestSluggerValidate(t *testing.T) {
+	s := Slugger{Reserved: DefaultReservedWords, OnReserved: ReservedSuffix}
+
+	var list = []struct {
+		field       string
+		expectation error
+	}{
+		{"hello-world", nil},
+		{"admin-1", nil},
+		{"admin", ErrReservedSlug},
+		{"log-in", ErrReservedSlug},
+		{"Hello world", ErrInvalidSlug},
+		{"", ErrInvalidSlug},
+	}
+
+	for _, l := range list {
+		if err := s.Validate(l.field); err != l.expectation {
+			t.Errorf("Slugger.Validate(%v): Result[%v]. Expected: %v", l.field, err, l.expectation)
+		}
+	}
+
+	if err := (Slugger{}).Validate("admin"); err != nil {
+		t.Errorf("Slugger{}.Validate(admin): Result[%v]. Expected: <nil>", err)
+	}
+}
+
+func Test_IsSlugFieldReserved(t *testing.T) {
+	var list = []struct {
+		field       string
+		expectation error
+	}{
+		{"Hello world", nil},
+		{"Admin", ErrReservedSlug},
+		{"Log In!", ErrReservedSlug},
+		{"~!@#$%^&*()_+", ErrInvalidSlug},
+	}
+
+	// the suffix action does not hide a reserved word from validation
+	for _, s := range []Slugger{{Reserved: DefaultReservedWords}, {Reserved: DefaultReservedWords, OnReserved: ReservedSuffix}} {
+		var rule fv.Rule = s.IsSlugField()
+
+		for _, l := range list {
+			if e, _ := rule.Validate([]string{l.field}, make(map[string]string)); e != l.expectation {
+				t.Errorf("IsSlugField(%s): Result[%v]. Expected: %v", l.field, e, l.expectation)
+			}
+		}
+	}
+}
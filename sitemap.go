@@ -0,0 +1,325 @@
+package slug
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// limits of one sitemap file and of a sitemap index, from the sitemaps.org protocol, the size is before compression
+const (
+	MaxSitemapURLs  = 50000
+	MaxSitemapBytes = 50 * 1024 * 1024
+
+	// longest <loc> of a URL, after percent-encoding
+	MaxSitemapURLLength = 2048
+)
+
+var (
+	ErrSitemapURL      = errors.New("The sitemap URL has an invalid change frequency or priority, or is too long.")
+	ErrSitemapTooLarge = errors.New("The sitemap needs more files than a sitemap index can list.")
+	ErrSitemapCreate   = errors.New("The sitemap writer has no Create function.")
+)
+
+const (
+	sitemapHeader = `<?xml version="1.0" encoding="UTF-8"?>` + "\n" + `<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">` + "\n"
+	sitemapFooter = "</urlset>\n"
+
+	sitemapIndexHeader = `<?xml version="1.0" encoding="UTF-8"?>` + "\n" + `<sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">` + "\n"
+	sitemapIndexFooter = "</sitemapindex>\n"
+)
+
+var sitemapChangeFreqs = map[string]bool{
+	"always": true, "hourly": true, "daily": true, "weekly": true, "monthly": true, "yearly": true, "never": true,
+}
+
+/*
+	One <url> entry of a sitemap. Path is not escaped, UTF-8 slugs are percent-encoded when written (Ex: "/blog/привет").
+	The zero LastMod, an empty ChangeFreq and a zero Priority are left out.
+*/
+type SitemapURL struct {
+	Path       string
+	LastMod    time.Time
+	ChangeFreq string  // always, hourly, daily, weekly, monthly, yearly or never
+	Priority   float64 // from 0.0 to 1.0
+}
+
+/*
+	Writes the sitemap files of a site and the sitemap index that lists them, the URLs are streamed so the slug tables do not have to fit in memory.
+	A new file is started at MaxURLs URLs or MaxBytes bytes, the files are "sitemap-1.xml", "sitemap-2.xml" and the index is "sitemap.xml".
+
+		w := slug.SitemapWriter{BaseURL: "https://example.com", Create: slug.SitemapDir("public"), Gzip: true}
+		names, err := w.Write(func(yield func(slug.SitemapURL) bool) {
+			for _, post := range posts {
+				if !yield(slug.SitemapURL{Path: "/blog/" + post.Slug, LastMod: post.Updated}) {
+					return
+				}
+			}
+		})
+*/
+type SitemapWriter struct {
+	// scheme and host of the URLs and of the sitemap files in the index (Ex: "https://example.com")
+	BaseURL string
+
+	// opens a file for writing, see SitemapDir
+	Create func(name string) (io.WriteCloser, error)
+
+	// compress every file, the names end with ".xml.gz"
+	Gzip bool
+
+	// base name of the files, "" is "sitemap"
+	Name string
+
+	// 0 is the limit of the protocol, MaxSitemapURLs and MaxSitemapBytes
+	MaxURLs  int
+	MaxBytes int
+}
+
+// creates the sitemap files in dir
+func SitemapDir(dir string) func(name string) (io.WriteCloser, error) {
+	return func(name string) (io.WriteCloser, error) {
+		return os.Create(filepath.Join(dir, name))
+	}
+}
+
+// a sitemap file being written
+type sitemapFile struct {
+	name    string
+	file    io.WriteCloser
+	gz      *gzip.Writer
+	buf     *bufio.Writer
+	urls    int
+	bytes   int
+	lastMod time.Time
+}
+
+/*
+	Writes the URLs, then the index. The names of the written files are returned, the index is last.
+	On error the files written so far are returned, the index is not written.
+*/
+func (w SitemapWriter) Write(urls func(yield func(SitemapURL) bool)) ([]string, error) {
+	if w.Create == nil {
+		return nil, ErrSitemapCreate
+	}
+
+	maxURLs, maxBytes := w.MaxURLs, w.MaxBytes
+	if maxURLs <= 0 || maxURLs > MaxSitemapURLs {
+		maxURLs = MaxSitemapURLs
+	}
+	if maxBytes <= 0 || maxBytes > MaxSitemapBytes {
+		maxBytes = MaxSitemapBytes
+	}
+
+	var names []string
+	var done []*sitemapFile
+	var current *sitemapFile
+	var err error
+	var entry bytes.Buffer
+
+	// closes the current file and starts the next one
+	next := func() error {
+		if current != nil {
+			// the file is closed even on error, so it is not closed again by Write
+			f := current
+			current = nil
+
+			if err := f.close(sitemapFooter); err != nil {
+				return err
+			}
+			names = append(names, f.name)
+			done = append(done, f)
+		}
+
+		if len(done) == MaxSitemapURLs {
+			return ErrSitemapTooLarge
+		}
+
+		current, err = w.open(fmt.Sprintf("%s-%d", w.name(), len(done)+1), sitemapHeader)
+		return err
+	}
+
+	urls(func(u SitemapURL) bool {
+		entry.Reset()
+		if err = w.writeURL(&entry, u); err != nil {
+			return false
+		}
+
+		if current == nil || current.urls == maxURLs || current.bytes+entry.Len()+len(sitemapFooter) > maxBytes {
+			if err = next(); err != nil {
+				return false
+			}
+
+			// too long for an empty file
+			if current.bytes+entry.Len()+len(sitemapFooter) > maxBytes {
+				err = ErrSitemapURL
+				return false
+			}
+		}
+
+		if err = current.write(entry.Bytes()); err != nil {
+			return false
+		}
+
+		current.urls++
+		if u.LastMod.After(current.lastMod) {
+			current.lastMod = u.LastMod
+		}
+		return true
+	})
+
+	// a site without URLs still gets an index with one empty sitemap
+	if err == nil && current == nil {
+		err = next()
+	}
+
+	if err != nil {
+		if current != nil {
+			current.file.Close()
+		}
+		return names, err
+	}
+
+	if err := current.close(sitemapFooter); err != nil {
+		return names, err
+	}
+	names = append(names, current.name)
+	done = append(done, current)
+
+	index, err := w.writeIndex(done)
+	if err != nil {
+		return names, err
+	}
+
+	return append(names, index), nil
+}
+
+func (w SitemapWriter) writeIndex(files []*sitemapFile) (string, error) {
+	index, err := w.open(w.name(), sitemapIndexHeader)
+	if err != nil {
+		return "", err
+	}
+
+	for _, f := range files {
+		var entry bytes.Buffer
+
+		entry.WriteString("  <sitemap>\n    <loc>")
+		xml.EscapeText(&entry, []byte(strings.TrimRight(w.BaseURL, "/")+"/"+f.name))
+		entry.WriteString("</loc>\n")
+		if !f.lastMod.IsZero() {
+			entry.WriteString("    <lastmod>" + f.lastMod.Format(time.RFC3339) + "</lastmod>\n")
+		}
+		entry.WriteString("  </sitemap>\n")
+
+		if err := index.write(entry.Bytes()); err != nil {
+			index.file.Close()
+			return "", err
+		}
+	}
+
+	if err := index.close(sitemapIndexFooter); err != nil {
+		return "", err
+	}
+
+	return index.name, nil
+}
+
+// one <url> element, the path is percent-encoded then escaped for XML, the URL is at most MaxSitemapURLLength bytes
+func (w SitemapWriter) writeURL(b *bytes.Buffer, u SitemapURL) error {
+	if (u.ChangeFreq != "" && !sitemapChangeFreqs[u.ChangeFreq]) || u.Priority < 0 || u.Priority > 1 {
+		return ErrSitemapURL
+	}
+
+	path := u.Path
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	loc := strings.TrimRight(w.BaseURL, "/") + (&url.URL{Path: path}).EscapedPath()
+	if len(loc) > MaxSitemapURLLength {
+		return ErrSitemapURL
+	}
+
+	b.WriteString("  <url>\n    <loc>")
+	xml.EscapeText(b, []byte(loc))
+	b.WriteString("</loc>\n")
+
+	if !u.LastMod.IsZero() {
+		b.WriteString("    <lastmod>" + u.LastMod.Format(time.RFC3339) + "</lastmod>\n")
+	}
+	if u.ChangeFreq != "" {
+		b.WriteString("    <changefreq>" + u.ChangeFreq + "</changefreq>\n")
+	}
+	if u.Priority != 0 {
+		b.WriteString("    <priority>" + strconv.FormatFloat(u.Priority, 'f', -1, 64) + "</priority>\n")
+	}
+
+	b.WriteString("  </url>\n")
+	return nil
+}
+
+func (w SitemapWriter) name() string {
+	if w.Name == "" {
+		return "sitemap"
+	}
+	return w.Name
+}
+
+// creates the file name.xml (or name.xml.gz) and writes the header
+func (w SitemapWriter) open(name, header string) (*sitemapFile, error) {
+	name += ".xml"
+	if w.Gzip {
+		name += ".gz"
+	}
+
+	file, err := w.Create(name)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &sitemapFile{name: name, file: file}
+	if w.Gzip {
+		f.gz = gzip.NewWriter(file)
+		f.buf = bufio.NewWriter(f.gz)
+	} else {
+		f.buf = bufio.NewWriter(file)
+	}
+
+	if err := f.write([]byte(header)); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (f *sitemapFile) write(p []byte) error {
+	n, err := f.buf.Write(p)
+	f.bytes += n
+	return err
+}
+
+// writes the footer, then flushes and closes the file
+func (f *sitemapFile) close(footer string) error {
+	err := f.write([]byte(footer))
+	if err == nil {
+		err = f.buf.Flush()
+	}
+	if err == nil && f.gz != nil {
+		err = f.gz.Close()
+	}
+
+	if cerr := f.file.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
@@ -0,0 +1,256 @@
+package slug
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// sitemap files kept in memory
+type memoryFiles map[string]*bytes.Buffer
+
+type memoryFile struct{ *bytes.Buffer }
+
+func (memoryFile) Close() error { return nil }
+
+func (m memoryFiles) create(name string) (io.WriteCloser, error) {
+	m[name] = new(bytes.Buffer)
+	return memoryFile{m[name]}, nil
+}
+
+// a file that fails every write and counts how often it is closed
+type failingFile struct {
+	err    error
+	closed *int
+}
+
+func (f *failingFile) Write(p []byte) (int, error) { return 0, f.err }
+func (f *failingFile) Close() error                { *f.closed++; return nil }
+
+type sitemapXML struct {
+	URLs []struct {
+		Loc        string `xml:"loc"`
+		LastMod    string `xml:"lastmod"`
+		ChangeFreq string `xml:"changefreq"`
+		Priority   string `xml:"priority"`
+	} `xml:"url"`
+	Sitemaps []struct {
+		Loc     string `xml:"loc"`
+		LastMod string `xml:"lastmod"`
+	} `xml:"sitemap"`
+}
+
+func parseSitemap(t *testing.T, data []byte) sitemapXML {
+	var s sitemapXML
+	if err := xml.Unmarshal(data, &s); err != nil {
+		t.Fatalf("xml.Unmarshal(): Error[%v]\n%s", err, data)
+	}
+	return s
+}
+
+func sitemapURLs(urls ...SitemapURL) func(yield func(SitemapURL) bool) {
+	return func(yield func(SitemapURL) bool) {
+		for _, u := range urls {
+			if !yield(u) {
+				return
+			}
+		}
+	}
+}
+
+func TestSitemapWriter(t *testing.T) {
+	files := memoryFiles{}
+	w := SitemapWriter{BaseURL: "https://example.com/", Create: files.create}
+
+	updated := time.Date(2017, 12, 29, 10, 30, 0, 0, time.UTC)
+	names, err := w.Write(sitemapURLs(
+		SitemapURL{Path: "/2017/12/29/hello-world", LastMod: updated, ChangeFreq: "weekly", Priority: 0.8},
+		SitemapURL{Path: "/blog/привет-мир"},
+		SitemapURL{Path: "tags/tom & jerry"},
+	))
+	if err != nil {
+		t.Fatalf("SitemapWriter.Write(): Error[%v]", err)
+	}
+
+	if strings.Join(names, ",") != "sitemap-1.xml,sitemap.xml" {
+		t.Fatalf("SitemapWriter.Write(): Files[%v]. Expected: sitemap-1.xml,sitemap.xml", names)
+	}
+
+	s := parseSitemap(t, files["sitemap-1.xml"].Bytes())
+	if len(s.URLs) != 3 {
+		t.Fatalf("sitemap-1.xml: %d URLs. Expected: 3", len(s.URLs))
+	}
+
+	var list = []stringStruct{
+		{s.URLs[0].Loc, "https://example.com/2017/12/29/hello-world"},
+		{s.URLs[0].LastMod, "2017-12-29T10:30:00Z"},
+		{s.URLs[0].ChangeFreq, "weekly"},
+		{s.URLs[0].Priority, "0.8"},
+		{s.URLs[1].Loc, "https://example.com/blog/%D0%BF%D1%80%D0%B8%D0%B2%D0%B5%D1%82-%D0%BC%D0%B8%D1%80"},
+		{s.URLs[1].LastMod, ""},
+		{s.URLs[2].Loc, "https://example.com/tags/tom%20&%20jerry"},
+	}
+
+	for _, l := range list {
+		if l.field != l.expectation {
+			t.Errorf("sitemap-1.xml: Result[%s]. Expected: %s", l.field, l.expectation)
+		}
+	}
+
+	// the ampersand is escaped in the file itself
+	if !strings.Contains(files["sitemap-1.xml"].String(), "tom%20&amp;%20jerry") {
+		t.Errorf("sitemap-1.xml: the & of the URL is not escaped\n%s", files["sitemap-1.xml"])
+	}
+
+	index := parseSitemap(t, files["sitemap.xml"].Bytes())
+	if len(index.Sitemaps) != 1 || index.Sitemaps[0].Loc != "https://example.com/sitemap-1.xml" || index.Sitemaps[0].LastMod != "2017-12-29T10:30:00Z" {
+		t.Errorf("sitemap.xml: Result[%+v]. Expected one sitemap, https://example.com/sitemap-1.xml", index.Sitemaps)
+	}
+}
+
+func TestSitemapWriterSplit(t *testing.T) {
+	var urls []SitemapURL
+	for i := 0; i < 7; i++ {
+		urls = append(urls, SitemapURL{Path: "/post-" + strconv.Itoa(i)})
+	}
+
+	// by number of URLs
+	files := memoryFiles{}
+	names, err := SitemapWriter{BaseURL: "https://example.com", Create: files.create, MaxURLs: 3}.Write(sitemapURLs(urls...))
+	if err != nil || strings.Join(names, ",") != "sitemap-1.xml,sitemap-2.xml,sitemap-3.xml,sitemap.xml" {
+		t.Fatalf("SitemapWriter{MaxURLs}.Write(): Files[%v] Error[%v]", names, err)
+	}
+	for name, count := range map[string]int{"sitemap-1.xml": 3, "sitemap-2.xml": 3, "sitemap-3.xml": 1} {
+		if s := parseSitemap(t, files[name].Bytes()); len(s.URLs) != count {
+			t.Errorf("%s: %d URLs. Expected: %d", name, len(s.URLs), count)
+		}
+	}
+	if index := parseSitemap(t, files["sitemap.xml"].Bytes()); len(index.Sitemaps) != 3 || index.Sitemaps[2].Loc != "https://example.com/sitemap-3.xml" {
+		t.Errorf("sitemap.xml: Result[%+v]. Expected 3 sitemaps", index.Sitemaps)
+	}
+
+	// by size, every file stays under the limit
+	files = memoryFiles{}
+	maxBytes := len(sitemapHeader) + len(sitemapFooter) + 200
+	names, err = SitemapWriter{BaseURL: "https://example.com", Create: files.create, MaxBytes: maxBytes}.Write(sitemapURLs(urls...))
+	if err != nil || len(names) < 3 {
+		t.Fatalf("SitemapWriter{MaxBytes}.Write(): Files[%v] Error[%v]", names, err)
+	}
+
+	total := 0
+	for _, name := range names[:len(names)-1] {
+		if files[name].Len() > maxBytes {
+			t.Errorf("%s: %d bytes. Expected at most: %d", name, files[name].Len(), maxBytes)
+		}
+		total += len(parseSitemap(t, files[name].Bytes()).URLs)
+	}
+	if total != len(urls) {
+		t.Errorf("SitemapWriter{MaxBytes}.Write(): %d URLs. Expected: %d", total, len(urls))
+	}
+
+	// a URL longer than an empty file
+	_, err = SitemapWriter{Create: memoryFiles{}.create, MaxBytes: maxBytes}.Write(sitemapURLs(SitemapURL{Path: strings.Repeat("a", 300)}))
+	if err != ErrSitemapURL {
+		t.Errorf("SitemapWriter{MaxBytes}.Write(long URL): Error[%v]. Expected: %v", err, ErrSitemapURL)
+	}
+}
+
+func TestSitemapWriterGzip(t *testing.T) {
+	files := memoryFiles{}
+	names, err := SitemapWriter{BaseURL: "https://example.com", Create: files.create, Gzip: true, Name: "posts"}.Write(sitemapURLs(SitemapURL{Path: "/hello-world"}))
+	if err != nil || strings.Join(names, ",") != "posts-1.xml.gz,posts.xml.gz" {
+		t.Fatalf("SitemapWriter{Gzip}.Write(): Files[%v] Error[%v]", names, err)
+	}
+
+	for _, name := range names {
+		r, err := gzip.NewReader(files[name])
+		if err != nil {
+			t.Fatalf("gzip.NewReader(%s): Error[%v]", name, err)
+		}
+		data, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatalf("%s: Error[%v]", name, err)
+		}
+
+		s := parseSitemap(t, data)
+		if name == "posts.xml.gz" && (len(s.Sitemaps) != 1 || s.Sitemaps[0].Loc != "https://example.com/posts-1.xml.gz") {
+			t.Errorf("%s: Result[%+v]. Expected: https://example.com/posts-1.xml.gz", name, s.Sitemaps)
+		}
+		if name == "posts-1.xml.gz" && (len(s.URLs) != 1 || s.URLs[0].Loc != "https://example.com/hello-world") {
+			t.Errorf("%s: Result[%+v]. Expected: https://example.com/hello-world", name, s.URLs)
+		}
+	}
+}
+
+func TestSitemapWriterPriority(t *testing.T) {
+	var list = []struct {
+		priority    float64
+		expectation string
+	}{
+		{0.85, "0.85"},
+		{0.04, "0.04"},
+		{0.5, "0.5"},
+		{1, "1"},
+		{0, ""},
+	}
+
+	for _, l := range list {
+		files := memoryFiles{}
+		if _, err := (SitemapWriter{Create: files.create}).Write(sitemapURLs(SitemapURL{Path: "/a", Priority: l.priority})); err != nil {
+			t.Fatalf("SitemapWriter.Write(%v): Error[%v]", l.priority, err)
+		}
+
+		if s := parseSitemap(t, files["sitemap-1.xml"].Bytes()); s.URLs[0].Priority != l.expectation {
+			t.Errorf("SitemapWriter.Write(%v): Priority[%s]. Expected: %s", l.priority, s.URLs[0].Priority, l.expectation)
+		}
+	}
+}
+
+func TestSitemapWriterErrors(t *testing.T) {
+	var list = []SitemapURL{
+		{Path: "/a", ChangeFreq: "sometimes"},
+		{Path: "/a", Priority: 1.5},
+		{Path: "/a", Priority: -0.1},
+		{Path: "/" + strings.Repeat("a", MaxSitemapURLLength)},
+	}
+
+	for _, u := range list {
+		files := memoryFiles{}
+		names, err := SitemapWriter{Create: files.create}.Write(sitemapURLs(SitemapURL{Path: "/ok"}, u))
+		if err != ErrSitemapURL {
+			t.Errorf("SitemapWriter.Write(%+v): Error[%v]. Expected: %v", u, err, ErrSitemapURL)
+		}
+		if len(names) != 0 || files["sitemap.xml"] != nil {
+			t.Errorf("SitemapWriter.Write(%+v): Files[%v]. Expected no index", u, names)
+		}
+	}
+
+	if _, err := (SitemapWriter{}).Write(sitemapURLs()); err != ErrSitemapCreate {
+		t.Errorf("SitemapWriter{}.Write(): Error[%v]. Expected: %v", err, ErrSitemapCreate)
+	}
+
+	failed := errors.New("disk full")
+	create := func(name string) (io.WriteCloser, error) { return nil, failed }
+	if _, err := (SitemapWriter{Create: create}).Write(sitemapURLs(SitemapURL{Path: "/a"})); err != failed {
+		t.Errorf("SitemapWriter.Write(): Error[%v]. Expected: %v", err, failed)
+	}
+
+	// a file that fails to close is closed once
+	var closed int
+	create = func(name string) (io.WriteCloser, error) { return &failingFile{err: failed, closed: &closed}, nil }
+	if _, err := (SitemapWriter{Create: create, MaxURLs: 1}).Write(sitemapURLs(SitemapURL{Path: "/a"}, SitemapURL{Path: "/b"})); err != failed || closed != 1 {
+		t.Errorf("SitemapWriter.Write(failing file): Error[%v] Closed[%d]. Expected: %v, closed once", err, closed, failed)
+	}
+
+	// no URLs is one empty sitemap
+	files := memoryFiles{}
+	if names, err := (SitemapWriter{Create: files.create}).Write(sitemapURLs()); err != nil || len(names) != 2 {
+		t.Errorf("SitemapWriter.Write(no URLs): Files[%v] Error[%v]. Expected: an empty sitemap and the index", names, err)
+	}
+}
@@ -1,25 +1,17 @@
 package slug
 
 import (
-	"errors"
 	"regexp"
-	"strings"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/rainycape/unidecode" // external dependency
 )
 
-var allowedSpecialChars, disallowedChars, multipleDashes *regexp.Regexp
 var isAsciiNumber, isSlugValid *regexp.Regexp
 var isTagValid, isTagListValid *regexp.Regexp
 
 func init() {
-	// characters that are replaced with a hypthen (-), (whitespaces, commas, dots, forward slashes, back slashes, hypthens, underscores, equal signs, and pluses)
-	// multiple hypthens are replaced with one (Ex: hello------world -> hello-world)
-	allowedSpecialChars = regexp.MustCompile(`[\s,./\\-_=+]+`)
-	disallowedChars = regexp.MustCompile(`[^A-Za-z0-9-]`)
-	multipleDashes = regexp.MustCompile("-+")
-
 	isAsciiNumber = regexp.MustCompile(`^[0-9]+$`)
 	isSlugValid = regexp.MustCompile(`^[A-Za-z0-9]+(?:-[A-Za-z0-9]+)*$`)
 
@@ -30,18 +22,99 @@ func init() {
 	//	isTagListValidAlternative = regexp.MustCompile(`^[a-z0-9]+(,*[a-z0-9]+(?:-[a-z0-9]+)*)*$`)
 }
 
+// characters that are replaced with a hypthen (-), (whitespaces, commas, dots, forward slashes, back slashes, hypthens, underscores, equal signs, and pluses)
+// the old regexp class [\s,./\\-_=+] also matched the range \ to _, so ] and ^ are kept as separators for identical output
+func isSeparator(c rune) bool {
+	switch c {
+	case '\t', '\n', '\f', '\r', ' ', ',', '.', '/', '\\', ']', '^', '_', '=', '+', '-':
+		return true
+	}
+	return false
+}
+
 func GetAsciiSlug(title string) string {
+	// short titles are built on the stack, only the returned string is allocated
+	var buf [64]byte
+	return string(AppendSlug(buf[:0], title))
+}
+
+/*
+	Appends the slug of title to dst and returns the extended buffer, the output is the same as GetAsciiSlug.
+	Reusing dst avoids allocating per call, an ASCII title is never allocated, other titles are transliterated first.
+*/
+func AppendSlug(dst []byte, title string) []byte {
+	start, dash := len(dst), false
+
+	if !isAsciiString(title) {
+		// replace unicode characters with ascii
+		for _, c := range unidecode.Unidecode(title) {
+			dst, dash = appendSlugRune(dst, start, dash, c)
+		}
+		return dst
+	}
 
-	// replace unicode characters with ascii
-	title = unidecode.Unidecode(title)
-	title = strings.ToLower(title)
+	for i := 0; i < len(title); i++ {
+		dst, dash = appendSlugRune(dst, start, dash, rune(title[i]))
+	}
+	return dst
+}
 
-	title = allowedSpecialChars.ReplaceAllString(title, "-")
-	title = disallowedChars.ReplaceAllString(title, "")
-	title = multipleDashes.ReplaceAllString(title, "-")
-	title = strings.Trim(title, "-")
+// same as AppendSlug for a title held in a byte slice
+func SlugBytes(dst []byte, title []byte) []byte {
+	start, dash := len(dst), false
 
-	return title
+	if !isAsciiBytes(title) {
+		for _, c := range unidecode.Unidecode(string(title)) {
+			dst, dash = appendSlugRune(dst, start, dash, c)
+		}
+		return dst
+	}
+
+	for i := 0; i < len(title); i++ {
+		dst, dash = appendSlugRune(dst, start, dash, rune(title[i]))
+	}
+	return dst
+}
+
+/*
+	One step of the single pass over the transliterated title, characters are lowercased and a run of separators becomes one hypthen (Ex: hello------world -> hello-world).
+	Any other character is dropped, hypthens are never leading or trailing. start is the length of dst before the slug, dash is whether a separator was seen since the last letter or number.
+*/
+func appendSlugRune(dst []byte, start int, dash bool, c rune) ([]byte, bool) {
+	if c >= utf8.RuneSelf {
+		c = unicode.ToLower(c)
+	} else if 'A' <= c && c <= 'Z' {
+		c += 'a' - 'A'
+	}
+
+	switch {
+	case 'a' <= c && c <= 'z', '0' <= c && c <= '9':
+		if dash && len(dst) > start {
+			dst = append(dst, '-')
+		}
+		return append(dst, byte(c)), false
+	case isSeparator(c):
+		return dst, true
+	}
+	return dst, dash
+}
+
+func isAsciiString(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+func isAsciiBytes(s []byte) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
 }
 
 func IsSlug(sl string) bool {
@@ -72,30 +145,39 @@ func IsUTF8Slug(sl string) bool {
 
 // for validating an HTML form field, implements an interface from another package
 type isSlugField struct {
+	slugger Slugger
 }
 
 func IsSlugField() isSlugField {
 	return isSlugField{}
 }
 
+// same as IsSlugField, the field is slugged and validated with the options of the Slugger
+func (s Slugger) IsSlugField() isSlugField {
+	return isSlugField{slugger: s}
+}
+
 /*
 	A slug must contain at least one ASCII letter or number after being parsed, it cannot be blank.
 	This uses another package with lots of examples in the "*_test.go" files
 */
 func (i isSlugField) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
 
-	var errMsg = errors.New("This field must contain at least one letter or number.")
 	var field = ""
 
 	if len(fields) > 0 {
 		field = fields[0]
 	}
 
-	field = GetAsciiSlug(field)
+	// reserved and blocked words are checked on the slug before any suffix, mask or removal
+	s := i.slugger
+	s.Reserved = nil
+	s.Blocked = nil
+	field = s.Slug(field)
 
-	if IsSlug(field) {
-		return nil, nil
+	if err := i.slugger.Validate(field); err != nil {
+		return err, nil
 	}
 
-	return errMsg, nil
+	return nil, nil
 }
@@ -2,8 +2,12 @@ package slug
 
 import (
 	"net/url"
+	"regexp"
+	"strings"
 	"testing"
+
 	fv "github.com/dholtzmann/formvalidator"
+	"github.com/rainycape/unidecode"
 )
 
 // expectation bool for whether the function will be valid, return a nil error
@@ -72,6 +76,77 @@ func TestGetSlug(t *testing.T) {
 		if l.expectation != result {
 			t.Errorf("GetAsciiSlug(%v): Result[%s]. Expected: %s", l.field, result, l.expectation)
 		}
+
+		if reference := getAsciiSlugRegexp(l.field); reference != result {
+			t.Errorf("GetAsciiSlug(%v): Result[%s]. Regexp pipeline: %s", l.field, result, reference)
+		}
+	}
+}
+
+// the regexp pipeline GetAsciiSlug used before the single pass scanner, kept to check for identical output
+var allowedSpecialChars = regexp.MustCompile(`[\s,./\\-_=+]+`)
+var disallowedChars = regexp.MustCompile(`[^A-Za-z0-9-]`)
+var multipleDashes = regexp.MustCompile("-+")
+
+func getAsciiSlugRegexp(title string) string {
+	title = unidecode.Unidecode(title)
+	title = strings.ToLower(title)
+
+	title = allowedSpecialChars.ReplaceAllString(title, "-")
+	title = disallowedChars.ReplaceAllString(title, "")
+	title = multipleDashes.ReplaceAllString(title, "-")
+	title = strings.Trim(title, "-")
+
+	return title
+}
+
+// every ASCII character and a sample of other runes between two letters, and in runs
+func TestGetSlugMatchesRegexp(t *testing.T) {
+	var list []string
+	for c := rune(0); c < 0x3000; c++ {
+		list = append(list, "a"+string(c)+"b", string(c)+string(c)+"x"+string(c), "Ab"+string(c)+" "+string(c)+"-C")
+	}
+	list = append(list, "\xff\xfe broken", "KELVIN \u212a", "\u0130stanbul", "]^[", "-_-")
+
+	for _, l := range list {
+		if result, reference := GetAsciiSlug(l), getAsciiSlugRegexp(l); result != reference {
+			t.Errorf("GetAsciiSlug(%q): Result[%s]. Regexp pipeline: %s", l, result, reference)
+		}
+	}
+}
+
+func TestAppendSlug(t *testing.T) {
+	var list = []string{
+		"", "Hello world!", "     Hello      world     ", "----This---is---a---test----", "~!@#$%^&*()_+{}|:'\"<>?/\\|[]",
+		"a~!@#$%^&*()_+{}b|:'\"<>?/\\|[]c", "Gültige Test", "中-文-网", "ひらがな・カタカナ、．漢字", "𐅪4", "\x19test\x7F",
+	}
+
+	for _, l := range list {
+		expectation := GetAsciiSlug(l)
+
+		// the existing content of dst is kept and does not affect the slug
+		for _, prefix := range []string{"", "/blog/", "-"} {
+			if result := string(AppendSlug([]byte(prefix), l)); result != prefix+expectation {
+				t.Errorf("AppendSlug(%q, %v): Result[%s]. Expected: %s", prefix, l, result, prefix+expectation)
+			}
+
+			if result := string(SlugBytes([]byte(prefix), []byte(l))); result != prefix+expectation {
+				t.Errorf("SlugBytes(%q, %v): Result[%s]. Expected: %s", prefix, l, result, prefix+expectation)
+			}
+		}
+	}
+}
+
+func TestAppendSlugAllocs(t *testing.T) {
+	buf := make([]byte, 0, 128)
+	title := []byte(benchmarkTitle)
+
+	if n := testing.AllocsPerRun(100, func() { buf = AppendSlug(buf[:0], benchmarkTitle) }); n != 0 {
+		t.Errorf("AppendSlug(): %v allocations per call. Expected: 0", n)
+	}
+
+	if n := testing.AllocsPerRun(100, func() { buf = SlugBytes(buf[:0], title) }); n != 0 {
+		t.Errorf("SlugBytes(): %v allocations per call. Expected: 0", n)
 	}
 }
 
@@ -319,11 +394,43 @@ func Test_formValidate(t *testing.T) {
 }
 
 func Benchmark_GetSlug(b *testing.B) {
+	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
 		GetAsciiSlug("ひらがな カタカナ 漢字")
 	}
 }
 
+func Benchmark_GetSlugRegexp(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		getAsciiSlugRegexp("ひらがな カタカナ 漢字")
+	}
+}
+
+const benchmarkTitle = "Hello World! An introduction to Golang, part 2 -- The +++ Standard_Library"
+
+func Benchmark_GetSlugAscii(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		GetAsciiSlug(benchmarkTitle)
+	}
+}
+
+func Benchmark_AppendSlug(b *testing.B) {
+	b.ReportAllocs()
+	buf := make([]byte, 0, 128)
+	for i := 0; i < b.N; i++ {
+		buf = AppendSlug(buf[:0], benchmarkTitle)
+	}
+}
+
+func Benchmark_GetSlugAsciiRegexp(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		getAsciiSlugRegexp(benchmarkTitle)
+	}
+}
+
 func Benchmark_IsSlug(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		IsSlug(",,,,,d,,,,,,,this is a test,here is another 123,test this,how about that,,,,,,s,,,,,,,")
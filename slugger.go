@@ -0,0 +1,164 @@
+package slug
+
+import (
+	"errors"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// reasons a slug is rejected by Slugger.Validate, the messages are shown to users of HTML forms
+var (
+	ErrInvalidSlug  = errors.New("This field must contain at least one letter or number.")
+	ErrReservedSlug = errors.New("This field cannot be a reserved word.")
+	ErrBlockedSlug  = errors.New("This field cannot contain a blocked word.")
+)
+
+/*
+	Options for slugs that differ from GetAsciiSlug, the zero value gives the same output as GetAsciiSlug.
+
+		s := slug.Slugger{Emoji: slug.EmojiWords}
+		s.Slug("I ❤ Go 🚀") // "i-red-heart-go-rocket"
+*/
+type Slugger struct {
+	Emoji EmojiMode
+
+	// zero width spaces and line separators separate words, other invisible and bidi control characters are removed (Ex: soft hyphens, BOM)
+	Invisible bool
+
+	// replace decimal digits of every script with ASCII digits before the transliteration, which drops most of them
+	FoldDigits bool
+
+	// what happens to apostrophes, the elision modes use the elided words of Language (Ex: French "l'", "qu'")
+	Apostrophes ApostropheMode
+
+	// replace symbols that would be dropped with words (Ex: "Tom & Jerry" -> "tom-and-jerry"), in the language of Language
+	Symbols bool
+	// symbols to replace in addition to (or instead of) the built-in words, an empty word removes the symbol, used when Symbols is set
+	SymbolWords map[rune]string
+
+	// language of the title, language.Und is treated as English
+	// it also selects the case mapping rules used to lowercase the title (Ex: Turkish dotted and dotless i)
+	Language language.Tag
+
+	// numbers with one decimal separator (Ex: "3.14") and with two or more dots (Ex: "1.21.3")
+	Decimals NumberFormat
+	Versions NumberFormat
+	// join digit groups (Ex: "1,000,000" -> "1000000"), the separators depend on Language
+	Thousands bool
+	// write a minus sign before a number as a word (Ex: "-5 degrees" -> "minus-5-degrees")
+	Negatives bool
+
+	// join dotted acronyms and initials into one word (Ex: "U.S.A. election" -> "usa-election")
+	Acronyms bool
+
+	// split words on case transitions before lowercasing (Ex: "HTTPServer" -> "http-server", "iPhoneApps" -> "iphone-apps")
+	SplitCase bool
+	// words that SplitCase does not split, matched exactly, nil is DefaultKeepWords (append to it to extend the list)
+	KeepWords []string
+
+	// slugs that cannot be used, nil allows every slug
+	Reserved *ReservedWords
+	// what happens to a generated slug that is reserved, validation always rejects them
+	OnReserved ReservedAction
+
+	// words that cannot appear in slugs and tags, nil allows every word
+	Blocked *BlockedWords
+	// what happens to a generated slug or tag with a blocked word, validation always rejects them
+	OnBlocked BlockedAction
+
+	// longest name in bytes returned by Filename, 0 is MaxFilenameBytes
+	FilenameMaxBytes int
+
+	// added before identifiers that start with a digit, "" is an underscore (Ex: "1st place" -> "_1st_place")
+	DigitPrefix string
+	// add an underscore to identifiers that are Go keywords (Ex: "type" -> "type_")
+	AvoidKeywords bool
+}
+
+func (s Slugger) Slug(title string) string {
+	var buf [64]byte
+	return string(s.AppendSlug(buf[:0], title))
+}
+
+// same as the package level AppendSlug, with the options applied
+func (s Slugger) AppendSlug(dst []byte, title string) []byte {
+	title = replaceEmoji(title, s.Emoji)
+
+	// after the emoji, which use zero width joiners and variation selectors
+	if s.Invisible {
+		title = replaceInvisible(title)
+	}
+
+	if s.FoldDigits {
+		title = FoldDigits(title)
+	}
+
+	if s.Apostrophes != ApostropheDrop {
+		title = s.replaceApostrophes(title)
+	}
+
+	if s.Symbols {
+		title = replaceSymbols(title, s.Language, s.SymbolWords)
+	}
+
+	if s.Thousands || s.Negatives || s.Decimals != NumberSplit || s.Versions != NumberSplit {
+		title = s.formatNumbers(title)
+	}
+
+	if s.Acronyms {
+		title = collapseAcronyms(title)
+	}
+
+	if s.SplitCase {
+		keep := s.KeepWords
+		if keep == nil {
+			keep = DefaultKeepWords
+		}
+		title = splitCaseTransitions(title, keep)
+	}
+
+	// the case transitions were used, lowercase before the transliteration can lose the difference
+	if s.Language != language.Und {
+		title = cases.Lower(s.Language).String(title)
+	}
+
+	start := len(dst)
+	if s.keepsDots() {
+		dst = appendSlugWithDots(dst, title)
+	} else {
+		dst = AppendSlug(dst, title)
+	}
+	dst = s.appendBlocked(dst, start)
+
+	return s.appendReserved(dst, start)
+}
+
+/*
+	Checks an existing slug against the options, a nil error means it can be used.
+	It must pass IsSlug, must not be a reserved word and must not contain a blocked word.
+	With NumberKeepDots, dots between two digits are allowed.
+*/
+func (s Slugger) Validate(sl string) error {
+	if s.keepsDots() {
+		if !isSlugWithDots(sl) {
+			return ErrInvalidSlug
+		}
+	} else if !IsSlug(sl) {
+		return ErrInvalidSlug
+	}
+
+	if s.Reserved != nil && s.Reserved.IsReserved(sl) {
+		return ErrReservedSlug
+	}
+
+	if s.Blocked != nil && s.Blocked.Contains(sl) {
+		return ErrBlockedSlug
+	}
+
+	return nil
+}
+
+func (s Slugger) keepsDots() bool {
+	return s.Decimals == NumberKeepDots || s.Versions == NumberKeepDots
+}
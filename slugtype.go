@@ -0,0 +1,194 @@
+package slug
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+/*
+	A slug that passed IsSlug, for struct fields that are decoded from JSON, forms or a database.
+	Parsing, unmarshaling, scanning, marshaling and storing an invalid slug fail with ErrInvalidSlug, "" is not a slug.
+	The zero value is an item without a slug, it can only come from null in JSON and NULL in SQL, and is written back as them.
+
+		var post struct {
+			Slug slug.Slug `json:"slug"`
+		}
+		json.Unmarshal([]byte(`{"slug": "Hello World"}`), &post) // ErrInvalidSlug
+*/
+type Slug string
+
+// same as Slug, validated with IsUTF8Slug
+type UTF8Slug string
+
+// converts sl, the error is ErrInvalidSlug when it does not pass IsSlug
+func ParseSlug(sl string) (Slug, error) {
+	if !IsSlug(sl) {
+		return "", ErrInvalidSlug
+	}
+	return Slug(sl), nil
+}
+
+// converts sl, the error is ErrInvalidSlug when it does not pass IsUTF8Slug
+func ParseUTF8Slug(sl string) (UTF8Slug, error) {
+	if !IsUTF8Slug(sl) {
+		return "", ErrInvalidSlug
+	}
+	return UTF8Slug(sl), nil
+}
+
+func (s Slug) String() string {
+	return string(s)
+}
+
+func (s Slug) MarshalText() ([]byte, error) {
+	if _, err := ParseSlug(string(s)); err != nil {
+		return nil, err
+	}
+	return []byte(s), nil
+}
+
+func (s *Slug) UnmarshalText(text []byte) error {
+	sl, err := ParseSlug(string(text))
+	if err != nil {
+		return err
+	}
+
+	*s = sl
+	return nil
+}
+
+// the zero value is null
+func (s Slug) MarshalJSON() ([]byte, error) {
+	if s == "" {
+		return []byte("null"), nil
+	}
+
+	if _, err := ParseSlug(string(s)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(s))
+}
+
+// null leaves the slug unchanged, like the encoding/json types
+func (s *Slug) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+
+	return s.UnmarshalText([]byte(text))
+}
+
+// implements sql.Scanner, a NULL column is the zero value
+func (s *Slug) Scan(src interface{}) error {
+	if src == nil {
+		*s = ""
+		return nil
+	}
+
+	text, err := scanSlug(src)
+	if err != nil {
+		return err
+	}
+
+	return s.UnmarshalText([]byte(text))
+}
+
+// implements driver.Valuer, the zero value is NULL
+func (s Slug) Value() (driver.Value, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	if _, err := ParseSlug(string(s)); err != nil {
+		return nil, err
+	}
+	return string(s), nil
+}
+
+func (s UTF8Slug) String() string {
+	return string(s)
+}
+
+func (s UTF8Slug) MarshalText() ([]byte, error) {
+	if _, err := ParseUTF8Slug(string(s)); err != nil {
+		return nil, err
+	}
+	return []byte(s), nil
+}
+
+func (s *UTF8Slug) UnmarshalText(text []byte) error {
+	sl, err := ParseUTF8Slug(string(text))
+	if err != nil {
+		return err
+	}
+
+	*s = sl
+	return nil
+}
+
+func (s UTF8Slug) MarshalJSON() ([]byte, error) {
+	if s == "" {
+		return []byte("null"), nil
+	}
+
+	if _, err := ParseUTF8Slug(string(s)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(s))
+}
+
+func (s *UTF8Slug) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+
+	return s.UnmarshalText([]byte(text))
+}
+
+func (s *UTF8Slug) Scan(src interface{}) error {
+	if src == nil {
+		*s = ""
+		return nil
+	}
+
+	text, err := scanSlug(src)
+	if err != nil {
+		return err
+	}
+
+	return s.UnmarshalText([]byte(text))
+}
+
+func (s UTF8Slug) Value() (driver.Value, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	if _, err := ParseUTF8Slug(string(s)); err != nil {
+		return nil, err
+	}
+	return string(s), nil
+}
+
+// the text of a database column, drivers return strings or byte slices for text columns
+func scanSlug(src interface{}) (string, error) {
+	switch v := src.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	}
+
+	return "", fmt.Errorf("A slug cannot be read from a column of type %T.", src)
+}
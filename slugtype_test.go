@@ -0,0 +1,157 @@
+package slug
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"testing"
+)
+
+var (
+	_ encoding.TextMarshaler   = Slug("")
+	_ encoding.TextUnmarshaler = (*Slug)(nil)
+	_ json.Marshaler           = Slug("")
+	_ json.Unmarshaler         = (*Slug)(nil)
+	_ sql.Scanner              = (*Slug)(nil)
+	_ driver.Valuer            = Slug("")
+
+	_ json.Marshaler = UTF8Slug("")
+	_ sql.Scanner    = (*UTF8Slug)(nil)
+	_ driver.Valuer  = UTF8Slug("")
+)
+
+func TestSlugJSON(t *testing.T) {
+	type post struct {
+		Slug Slug     `json:"slug"`
+		Tag  UTF8Slug `json:"tag"`
+	}
+
+	var list = []struct {
+		field string
+		slug  Slug
+		tag   UTF8Slug
+		valid bool
+	}{
+		{`{"slug": "hello-world", "tag": "привет-мир"}`, "hello-world", "привет-мир", true},
+		{`{"slug": null, "tag": null}`, "", "", true},
+		{`{"slug": "", "tag": "hello"}`, "", "", false},
+		{`{"slug": "hello", "tag": ""}`, "", "", false},
+		{`{}`, "", "", true},
+		{`{"slug": "Hello World"}`, "", "", false},
+		{`{"slug": "hello--world"}`, "", "", false},
+		{`{"slug": "-hello"}`, "", "", false},
+		{`{"slug": "привет-мир"}`, "", "", false},
+		{`{"tag": "привет мир"}`, "", "", false},
+		{`{"slug": 42}`, "", "", false},
+	}
+
+	for _, l := range list {
+		var p post
+		err := json.Unmarshal([]byte(l.field), &p)
+
+		if (err == nil) != l.valid {
+			t.Errorf("json.Unmarshal(%s): Error[%v]. Expected valid: %v", l.field, err, l.valid)
+			continue
+		}
+		if err == nil && (p.Slug != l.slug || p.Tag != l.tag) {
+			t.Errorf("json.Unmarshal(%s): Result[%s %s]. Expected: %s %s", l.field, p.Slug, p.Tag, l.slug, l.tag)
+		}
+	}
+
+	if data, err := json.Marshal(post{Slug: "hello-world", Tag: "über-uns"}); err != nil || string(data) != `{"slug":"hello-world","tag":"über-uns"}` {
+		t.Errorf("json.Marshal(): Result[%s] Error[%v]", data, err)
+	}
+
+	if data, err := json.Marshal(post{}); err != nil || string(data) != `{"slug":null,"tag":null}` {
+		t.Errorf("json.Marshal(): Result[%s] Error[%v]. Expected: null slugs", data, err)
+	}
+
+	// a slug converted without ParseSlug is still checked
+	if _, err := json.Marshal(post{Slug: "Hello World"}); err == nil {
+		t.Errorf("json.Marshal(Hello World): Error[<nil>]. Expected: %v", ErrInvalidSlug)
+	}
+}
+
+func TestSlugText(t *testing.T) {
+	var list = []struct {
+		field string
+		valid bool
+	}{
+		{"hello-world", true},
+		{"2017", true},
+		{"", false},
+		{"hello world", false},
+		{"hello-", false},
+		{"Über", false},
+	}
+
+	for _, l := range list {
+		var s Slug
+		if err := s.UnmarshalText([]byte(l.field)); (err == nil) != l.valid || (err == nil && string(s) != l.field) {
+			t.Errorf("Slug.UnmarshalText(%v): Result[%s] Error[%v]. Expected valid: %v", l.field, s, err, l.valid)
+		}
+
+		if _, err := Slug(l.field).MarshalText(); (err == nil) != l.valid {
+			t.Errorf("Slug.MarshalText(%v): Error[%v]. Expected valid: %v", l.field, err, l.valid)
+		}
+
+		if sl, err := ParseSlug(l.field); (err == nil) != l.valid || (err != nil && err != ErrInvalidSlug) || (err == nil && sl.String() != l.field) {
+			t.Errorf("ParseSlug(%v): Result[%s] Error[%v]. Expected valid: %v", l.field, sl, err, l.valid)
+		}
+	}
+
+	if _, err := ParseUTF8Slug("Über-uns"); err != nil {
+		t.Errorf("ParseUTF8Slug(Über-uns): Error[%v]. Expected: <nil>", err)
+	}
+	if _, err := ParseUTF8Slug("über uns"); err != ErrInvalidSlug {
+		t.Errorf("ParseUTF8Slug(über uns): Error[%v]. Expected: %v", err, ErrInvalidSlug)
+	}
+}
+
+func TestSlugSQL(t *testing.T) {
+	var list = []struct {
+		src   interface{}
+		slug  Slug
+		valid bool
+	}{
+		{"hello-world", "hello-world", true},
+		{[]byte("hello-world"), "hello-world", true},
+		{nil, "", true},
+		{"", "", false},
+		{"hello world", "", false},
+		{42, "", false},
+	}
+
+	for _, l := range list {
+		s := Slug("previous")
+		err := s.Scan(l.src)
+
+		if (err == nil) != l.valid {
+			t.Errorf("Slug.Scan(%v): Error[%v]. Expected valid: %v", l.src, err, l.valid)
+		} else if err == nil && s != l.slug {
+			t.Errorf("Slug.Scan(%v): Result[%s]. Expected: %s", l.src, s, l.slug)
+		}
+	}
+
+	if v, err := Slug("hello-world").Value(); err != nil || v != "hello-world" {
+		t.Errorf("Slug.Value(hello-world): Result[%v] Error[%v]. Expected: hello-world", v, err)
+	}
+	if v, err := Slug("").Value(); err != nil || v != nil {
+		t.Errorf("Slug.Value(\"\"): Result[%v] Error[%v]. Expected: <nil>", v, err)
+	}
+	if _, err := Slug("Hello World").Value(); err != ErrInvalidSlug {
+		t.Errorf("Slug.Value(Hello World): Error[%v]. Expected: %v", err, ErrInvalidSlug)
+	}
+
+	var u UTF8Slug
+	if err := u.Scan([]byte("привет-мир")); err != nil || u != "привет-мир" {
+		t.Errorf("UTF8Slug.Scan(привет-мир): Result[%s] Error[%v]. Expected: привет-мир", u, err)
+	}
+	if v, err := u.Value(); err != nil || v != "привет-мир" {
+		t.Errorf("UTF8Slug.Value(привет-мир): Result[%v] Error[%v]. Expected: привет-мир", v, err)
+	}
+	if err := u.Scan("привет мир"); err != ErrInvalidSlug {
+		t.Errorf("UTF8Slug.Scan(привет мир): Error[%v]. Expected: %v", err, ErrInvalidSlug)
+	}
+}
@@ -0,0 +1,117 @@
+package slug
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"strings"
+)
+
+// the alphabet of a hash suffix
+type SuffixEncoding int
+
+const (
+	SuffixBase36 SuffixEncoding = iota // lower case letters and digits, like the rest of the slug
+	SuffixBase62                       // upper and lower case letters and digits, shorter for the same number of hashes but URLs become case sensitive
+)
+
+const base62Alphabet = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// suffix length used when HashSuffix.Length is 0, 36^6 is about two billion values
+const DefaultSuffixLength = 6
+
+/*
+	Adds a short deterministic suffix to slugs, so slugs of equal titles differ without looking up the existing ones.
+	The suffix is the hash of a key, the ID or the content of the item, so the same item always gets the same slug.
+
+		h := slug.HashSuffix{}
+		h.Slug("Hello World", "42")  // "hello-world-87ui6l"
+		h.Split("hello-world-87ui6l") // "hello-world", "87ui6l", true
+*/
+type HashSuffix struct {
+	Encoding SuffixEncoding
+	// characters of the suffix, 0 is DefaultSuffixLength, at most the 64 bits of the hash (13 in base36, 11 in base62)
+	Length int
+}
+
+// GetAsciiSlug with a base36 suffix of DefaultSuffixLength characters
+func GetSuffixedSlug(title, key string) string {
+	return HashSuffix{}.Slug(title, key)
+}
+
+// the slug of title followed by a hypthen and the suffix of key, only the suffix if the title has no letters or numbers
+func (h HashSuffix) Slug(title, key string) string {
+	sl := GetAsciiSlug(title)
+	if sl == "" {
+		return h.Suffix(key)
+	}
+
+	return sl + "-" + h.Suffix(key)
+}
+
+// the first 64 bits of the SHA-256 hash of key, written with exactly Length characters
+func (h HashSuffix) Suffix(key string) string {
+	alphabet, length := h.alphabet(), h.length()
+
+	sum := sha256.Sum256([]byte(key))
+	n := binary.BigEndian.Uint64(sum[:8])
+	base := uint64(len(alphabet))
+
+	suffix := make([]byte, length)
+	for i := length - 1; i >= 0; i-- {
+		suffix[i] = alphabet[n%base]
+		n /= base
+	}
+
+	return string(suffix)
+}
+
+/*
+	Separates the suffix from a slug made by Slug, for looking up the item by its suffix.
+	False when the slug does not end with a suffix of the length and alphabet of h, or when the part before it is not a slug.
+*/
+func (h HashSuffix) Split(sl string) (string, string, bool) {
+	alphabet, length := h.alphabet(), h.length()
+
+	if len(sl) < length {
+		return "", "", false
+	}
+
+	base, suffix := sl[:len(sl)-length], sl[len(sl)-length:]
+	for i := 0; i < len(suffix); i++ {
+		if strings.IndexByte(alphabet, suffix[i]) < 0 {
+			return "", "", false
+		}
+	}
+
+	switch {
+	case base == "":
+		return "", suffix, true
+	case strings.HasSuffix(base, "-") && IsSlug(base[:len(base)-1]):
+		return base[:len(base)-1], suffix, true
+	}
+
+	return "", "", false
+}
+
+func (h HashSuffix) alphabet() string {
+	if h.Encoding == SuffixBase62 {
+		return base62Alphabet
+	}
+	return base62Alphabet[:36]
+}
+
+// digits of a 64 bit number in the alphabet
+func (h HashSuffix) length() int {
+	max := 13
+	if h.Encoding == SuffixBase62 {
+		max = 11
+	}
+
+	switch {
+	case h.Length <= 0:
+		return DefaultSuffixLength
+	case h.Length > max:
+		return max
+	}
+	return h.Length
+}
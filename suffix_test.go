@@ -0,0 +1,98 @@
+package slug
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestHashSuffix(t *testing.T) {
+	var list = []struct {
+		suffix HashSuffix
+		length int
+		valid  func(c byte) bool
+	}{
+		{HashSuffix{}, DefaultSuffixLength, func(c byte) bool { return c >= 'a' && c <= 'z' || isDigit(rune(c)) }},
+		{HashSuffix{Length: 4}, 4, func(c byte) bool { return c >= 'a' && c <= 'z' || isDigit(rune(c)) }},
+		{HashSuffix{Length: 50}, 13, func(c byte) bool { return c >= 'a' && c <= 'z' || isDigit(rune(c)) }},
+		{HashSuffix{Encoding: SuffixBase62, Length: 8}, 8, func(c byte) bool { return isSlugValid.MatchString(string(c)) }},
+		{HashSuffix{Encoding: SuffixBase62, Length: 50}, 11, func(c byte) bool { return isSlugValid.MatchString(string(c)) }},
+	}
+
+	for _, l := range list {
+		for id := 0; id < 100; id++ {
+			suffix := l.suffix.Suffix(strconv.Itoa(id))
+
+			if len(suffix) != l.length {
+				t.Errorf("%+v.Suffix(%d): Result[%s]. Expected length: %d", l.suffix, id, suffix, l.length)
+			}
+			for i := 0; i < len(suffix); i++ {
+				if !l.valid(suffix[i]) {
+					t.Errorf("%+v.Suffix(%d): Result[%s]. Unexpected character: %q", l.suffix, id, suffix, suffix[i])
+				}
+			}
+		}
+	}
+
+	// deterministic, and different keys give different suffixes
+	h := HashSuffix{}
+	if h.Suffix("42") != h.Suffix("42") || h.Suffix("42") == h.Suffix("43") {
+		t.Errorf("HashSuffix.Suffix(): the suffix of a key must not change and must differ from other keys")
+	}
+}
+
+func TestHashSuffixSlug(t *testing.T) {
+	h := HashSuffix{}
+	suffix := h.Suffix("42")
+
+	if result := h.Slug("Hello World!", "42"); result != "hello-world-"+suffix {
+		t.Errorf("HashSuffix.Slug(Hello World!): Result[%s]. Expected: hello-world-%s", result, suffix)
+	}
+	if result := GetSuffixedSlug("Hello World!", "42"); result != "hello-world-"+suffix {
+		t.Errorf("GetSuffixedSlug(Hello World!): Result[%s]. Expected: hello-world-%s", result, suffix)
+	}
+	if result := h.Slug("!!!", "42"); result != suffix {
+		t.Errorf("HashSuffix.Slug(!!!): Result[%s]. Expected: %s", result, suffix)
+	}
+	if result := h.Slug("Hello World!", "42"); !IsSlug(result) {
+		t.Errorf("HashSuffix.Slug(Hello World!): Result[%s] is not a slug", result)
+	}
+}
+
+func TestHashSuffixSplit(t *testing.T) {
+	var list = []struct {
+		suffix HashSuffix
+		field  string
+		base   string
+		hash   string
+		ok     bool
+	}{
+		{HashSuffix{}, "hello-world-3k9x0q", "hello-world", "3k9x0q", true},
+		{HashSuffix{}, GetSuffixedSlug("Hello World", "42"), "hello-world", "87ui6l", true},
+		{HashSuffix{}, "3k9x0q", "", "3k9x0q", true},
+		{HashSuffix{}, "a-3k9x0q", "a", "3k9x0q", true},
+		{HashSuffix{}, "hello-world-3K9x0q", "", "", false},
+		{HashSuffix{}, "hello-world3k9x0q", "", "", false},
+		{HashSuffix{}, "-3k9x0q", "", "", false},
+		{HashSuffix{}, "a--3k9x0q", "", "", false},
+		{HashSuffix{}, "3k9x0", "", "", false},
+		{HashSuffix{}, "", "", "", false},
+		{HashSuffix{Encoding: SuffixBase62, Length: 4}, "hello-world-3K9x", "hello-world", "3K9x", true},
+		{HashSuffix{Encoding: SuffixBase62, Length: 4}, "hello-world-3K9_", "", "", false},
+	}
+
+	for _, l := range list {
+		base, hash, ok := l.suffix.Split(l.field)
+		if base != l.base || hash != l.hash || ok != l.ok {
+			t.Errorf("%+v.Split(%v): Result[%s %s %v]. Expected: %s %s %v", l.suffix, l.field, base, hash, ok, l.base, l.hash, l.ok)
+		}
+	}
+
+	// a slug made by Slug is split back into the slug of the title and the suffix of the key
+	for _, h := range []HashSuffix{{}, {Length: 10}, {Encoding: SuffixBase62}} {
+		sl := h.Slug("An introduction to Golang", "content of the post")
+
+		if base, hash, ok := h.Split(sl); !ok || base != "an-introduction-to-golang" || hash != h.Suffix("content of the post") {
+			t.Errorf("%+v.Split(%v): Result[%s %s %v]. Expected the slug of the title and the suffix of the key", h, sl, base, hash, ok)
+		}
+	}
+}
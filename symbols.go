@@ -0,0 +1,48 @@
+package slug
+
+import (
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// words for symbols that would otherwise be dropped, see byLanguage
+var symbolWords = map[string]map[rune]string{
+	"en": {'&': "and", '@': "at", '%': "percent", '‰': "per mille", '#': "number", '°': "degrees",
+		'$': "dollar", '€': "euro", '£': "pound", '¥': "yen", '₹': "rupee", '₽': "ruble", '₩': "won", '¢': "cent"},
+	"de": {'&': "und", '@': "at", '%': "Prozent", '‰': "Promille", '#': "Nummer", '°': "Grad",
+		'$': "Dollar", '€': "Euro", '£': "Pfund", '¥': "Yen", '₹': "Rupie", '₽': "Rubel", '₩': "Won", '¢': "Cent"},
+	"fr": {'&': "et", '@': "arobase", '%': "pour cent", '‰': "pour mille", '#': "numero", '°': "degres",
+		'$': "dollar", '€': "euro", '£': "livre", '¥': "yen", '₹': "roupie", '₽': "rouble", '₩': "won", '¢': "cent"},
+	"es": {'&': "y", '@': "arroba", '%': "por ciento", '‰': "por mil", '#': "numero", '°': "grados",
+		'$': "dolar", '€': "euro", '£': "libra", '¥': "yen", '₹': "rupia", '₽': "rublo", '₩': "won", '¢': "centavo"},
+	"it": {'&': "e", '@': "chiocciola", '%': "percento", '‰': "per mille", '#': "numero", '°': "gradi",
+		'$': "dollaro", '€': "euro", '£': "sterlina", '¥': "yen", '₹': "rupia", '₽': "rublo", '₩': "won", '¢': "centesimo"},
+	"pt": {'&': "e", '@': "arroba", '%': "por cento", '‰': "por mil", '#': "numero", '°': "graus",
+		'$': "dolar", '€': "euro", '£': "libra", '¥': "iene", '₹': "rupia", '₽': "rublo", '₩': "won", '¢': "centavo"},
+	"nl": {'&': "en", '@': "at", '%': "procent", '‰': "promille", '#': "nummer", '°': "graden",
+		'$': "dollar", '€': "euro", '£': "pond", '¥': "yen", '₹': "roepie", '₽': "roebel", '₩': "won", '¢': "cent"},
+}
+
+// replaces symbols with words surrounded by spaces, overrides are checked first and an empty word removes the symbol
+func replaceSymbols(title string, lang language.Tag, overrides map[rune]string) string {
+	table := byLanguage(symbolWords, lang)
+
+	var b strings.Builder
+	b.Grow(len(title))
+
+	for _, c := range title {
+		word, found := overrides[c]
+		if !found {
+			word, found = table[c]
+		}
+
+		if !found {
+			b.WriteRune(c)
+		} else if word != "" {
+			b.WriteString(" " + word + " ")
+		}
+	}
+
+	return b.String()
+}
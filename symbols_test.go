@@ -0,0 +1,51 @@
+package slug
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestSluggerSymbols(t *testing.T) {
+	var list = []struct {
+		field       string
+		lang        language.Tag
+		expectation string
+	}{
+		{"Tom & Jerry", language.Und, "tom-and-jerry"},
+		{"Tom & Jerry", language.English, "tom-and-jerry"},
+		{"Tom & Jerry", language.German, "tom-und-jerry"},
+		{"Tom & Jerry", language.MustParse("de-AT"), "tom-und-jerry"},
+		{"Tom & Jerry", language.French, "tom-et-jerry"},
+		{"Tom & Jerry", language.Japanese, "tom-and-jerry"}, // no table, English is used
+		{"100% Go", language.English, "100-percent-go"},
+		{"100% Go", language.French, "100-pour-cent-go"},
+		{"me@example", language.English, "me-at-example"},
+		{"$5 or 5€ or £5 or ¥5", language.English, "dollar-5-or-5-euro-or-pound-5-or-yen-5"},
+		{"5€", language.German, "5-euro"},
+		{"AT&T", language.English, "at-and-t"},
+		{"1+1=2", language.English, "1-1-2"}, // separators are not symbols
+	}
+
+	for _, l := range list {
+		s := Slugger{Symbols: true, Language: l.lang}
+
+		if result := s.Slug(l.field); result != l.expectation {
+			t.Errorf("Slugger{Symbols, %s}.Slug(%q): Result[%s]. Expected: %s", l.lang, l.field, result, l.expectation)
+		}
+	}
+}
+
+func TestSluggerSymbolOverrides(t *testing.T) {
+	s := Slugger{Symbols: true, SymbolWords: map[rune]string{'&': "n", '#': "", '+': "plus"}}
+
+	if result := s.Slug("Rock & Roll #1 C++"); result != "rock-n-roll-1-c-plus-plus" {
+		t.Errorf("Slugger{SymbolWords}.Slug(): Result[%s]. Expected: rock-n-roll-1-c-plus-plus", result)
+	}
+
+	// without Symbols the overrides are not used
+	s.Symbols = false
+	if result := s.Slug("Tom & Jerry"); result != "tom-jerry" {
+		t.Errorf("Slugger{}.Slug(): Result[%s]. Expected: tom-jerry", result)
+	}
+}
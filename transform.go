@@ -0,0 +1,68 @@
+package slug
+
+import (
+	"unicode/utf8"
+
+	"github.com/rainycape/unidecode"
+	"golang.org/x/text/transform" // external dependency
+)
+
+/*
+	Streaming version of GetAsciiSlug for transform.Chain and transform.NewReader, the output is the same as GetAsciiSlug of the whole input.
+	Runes split across buffers are waited for, and a run of separators is only written as a hypthen when a letter or number follows it, so the
+	result does not depend on where the input was split.
+*/
+func SlugTransformer() transform.Transformer {
+	return &slugTransformer{}
+}
+
+type slugTransformer struct {
+	dash    bool // a separator was seen since the last letter or number
+	written bool // something was written, a hypthen may follow
+}
+
+func (t *slugTransformer) Reset() {
+	*t = slugTransformer{}
+}
+
+func (t *slugTransformer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	// enough for the longest transliteration of a single rune
+	var buf [32]byte
+
+	for nSrc < len(src) {
+		c, size := rune(src[nSrc]), 1
+		if c >= utf8.RuneSelf {
+			if !atEOF && !utf8.FullRune(src[nSrc:]) {
+				return nDst, nSrc, transform.ErrShortSrc
+			}
+			c, size = utf8.DecodeRune(src[nSrc:])
+		}
+
+		// everything before the slug counts as written, so a pending hypthen is kept
+		start := 0
+		if t.written {
+			start = -1
+		}
+
+		out, dash := buf[:0], t.dash
+		if c < utf8.RuneSelf {
+			out, dash = appendSlugRune(out, start, dash, c)
+		} else {
+			// replace unicode characters with ascii
+			for _, d := range unidecode.Unidecode(string(c)) {
+				out, dash = appendSlugRune(out, start, dash, d)
+			}
+		}
+
+		if len(out) > len(dst)-nDst {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+
+		nDst += copy(dst[nDst:], out)
+		nSrc += size
+		t.dash = dash
+		t.written = t.written || len(out) > 0
+	}
+
+	return nDst, nSrc, nil
+}
@@ -0,0 +1,103 @@
+package slug
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"golang.org/x/text/transform"
+)
+
+var transformList = []string{
+	"",
+	"Hello world!",
+	"     Hello      world     ",
+	"----This---is---a---test----",
+	"a~!@#$%^&*()_+{}b|:'\"<>?/\\|[]c",
+	"Forneça here",
+	"gültige@Heiẞe.de",
+	"中-文-网",
+	"ひらがな・カタカナ、．漢字",
+	"３ー０　ａ＠ｃｏｍ",
+	"𐅪4",
+	"test-¾",
+	"\xff\xfebroken utf-8\xe3\x81",
+	"	àåáâäãåą	èéêëę,ìíîïı.òóôõöøőð/ùúûüŭů\\çćčĉ-żźž_śşšŝ=ñń++++++ýÿ     ğĝ ř ł     đ ß Þ ĥ ĵ   ",
+}
+
+func TestSlugTransformer(t *testing.T) {
+	for _, l := range transformList {
+		expectation := GetAsciiSlug(l)
+
+		result, _, err := transform.String(SlugTransformer(), l)
+		if err != nil || result != expectation {
+			t.Errorf("transform.String(%q): Result[%s] Error[%v]. Expected: %s", l, result, err, expectation)
+		}
+
+		// one byte at a time, runes and separator runs are split across reads
+		b, err := io.ReadAll(transform.NewReader(iotest.OneByteReader(strings.NewReader(l)), SlugTransformer()))
+		if err != nil || string(b) != expectation {
+			t.Errorf("transform.NewReader(%q): Result[%s] Error[%v]. Expected: %s", l, b, err, expectation)
+		}
+	}
+}
+
+// drives Transform directly with tiny source and destination buffers, 16 bytes holds any single transliteration
+func TestSlugTransformerShortBuffers(t *testing.T) {
+	tr := SlugTransformer()
+
+	for _, l := range transformList {
+		expectation := GetAsciiSlug(l)
+		tr.Reset()
+
+		var result []byte
+		src := []byte(l)
+		dst := make([]byte, 16)
+
+		for chunk := 1; ; {
+			n := chunk
+			if n > len(src) {
+				n = len(src)
+			}
+			atEOF := n == len(src)
+
+			nDst, nSrc, err := tr.Transform(dst, src[:n], atEOF)
+			result = append(result, dst[:nDst]...)
+			src = src[nSrc:]
+
+			if err != nil && err != transform.ErrShortDst && err != transform.ErrShortSrc {
+				t.Fatalf("Transform(%q): Error[%v]", l, err)
+			}
+			if err == transform.ErrShortSrc {
+				chunk++
+			} else {
+				chunk = 1
+			}
+			if atEOF && err == nil {
+				break
+			}
+		}
+
+		if string(result) != expectation {
+			t.Errorf("Transform(%q): Result[%s]. Expected: %s", l, result, expectation)
+		}
+	}
+}
+
+func TestSlugTransformerChain(t *testing.T) {
+	chain := transform.Chain(SlugTransformer(), transform.Nop)
+
+	result, _, err := transform.String(chain, "Hello World! An introduction to Golang.")
+	if err != nil || result != "hello-world-an-introduction-to-golang" {
+		t.Errorf("transform.Chain(): Result[%s] Error[%v]", result, err)
+	}
+}
+
+func Benchmark_SlugTransformer(b *testing.B) {
+	b.ReportAllocs()
+	tr := SlugTransformer()
+	for i := 0; i < b.N; i++ {
+		transform.String(tr, benchmarkTitle)
+	}
+}